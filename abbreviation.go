@@ -0,0 +1,120 @@
+package tz
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Hint narrows the zones ResolveAbbreviation returns to those of
+// a country, a continent or both; the fields left empty match any.
+type Hint struct {
+	CountryCode string
+	Continent   Continent
+}
+
+// matches returns whether the zone matches h.
+func (h Hint) matches(z Zone) bool {
+	if h.CountryCode != "" && !strings.EqualFold(h.CountryCode, z.CountryCode) {
+		return false
+	}
+	if h.Continent != "" && h.Continent != z.Continent() {
+		return false
+	}
+	return true
+}
+
+// abbreviationUse is a zone using an abbreviation,
+// and whether it still does this year.
+type abbreviationUse struct {
+	zone    Zone
+	current bool
+}
+
+var (
+	abbreviationsOnce sync.Once
+	abbreviations     map[string][]abbreviationUse
+)
+
+// indexAbbreviations indexes the alphabetic abbreviations
+// used by each zone since 1970.
+func indexAbbreviations() {
+	abbreviations = make(map[string][]abbreviationUse)
+
+	now := time.Now()
+	thisYear := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := thisYear.AddDate(1, 0, 0)
+
+	for _, z := range zonesList {
+		loc, err := LoadLocation(z.Name)
+		if err != nil {
+			continue
+		}
+
+		used := make(map[string]bool)
+
+		t := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC).In(loc)
+		for t.Before(end) {
+			name, _ := t.Zone()
+			_, next := t.ZoneBounds()
+
+			if name != "" && name[0] != '+' && name[0] != '-' {
+				used[name] = used[name] || next.IsZero() || next.After(thisYear)
+			}
+
+			if next.IsZero() {
+				break
+			}
+			t = next.In(loc)
+		}
+
+		for name, current := range used {
+			abbreviations[name] = append(abbreviations[name], abbreviationUse{zone: z, current: current})
+		}
+	}
+}
+
+// ResolveAbbreviation returns the zones that have used the time zone
+// abbreviation passed since 1970, eg. America/Chicago, Asia/Shanghai
+// and America/Havana among others for "CST", narrowed to those matching
+// any of the hints passed. Zones still using the abbreviation come
+// first, then Common zones, then by name.
+func ResolveAbbreviation(abbr string, hints ...Hint) []Zone {
+	load()
+	abbreviationsOnce.Do(indexAbbreviations)
+
+	uses := abbreviations[strings.ToUpper(strings.TrimSpace(abbr))]
+
+	var matched []abbreviationUse
+
+	for _, u := range uses {
+		ok := len(hints) == 0
+		for _, h := range hints {
+			if h.matches(u.zone) {
+				ok = true
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, u)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if a.current != b.current {
+			return a.current
+		}
+		if a.zone.Common != b.zone.Common {
+			return a.zone.Common
+		}
+		return a.zone.Name < b.zone.Name
+	})
+
+	zs := make([]Zone, len(matched))
+	for i, u := range matched {
+		zs[i] = u.zone
+	}
+	return zs
+}
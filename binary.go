@@ -0,0 +1,245 @@
+package tz
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// binaryVersion is the version of the binary encoding,
+// leading each MarshalBinary output.
+const binaryVersion = 1
+
+// errBinary is returned when unmarshaling invalid binary data.
+var errBinary = errors.New("tz: invalid binary data")
+
+// Flags of the Zone and Country binary encodings.
+const (
+	flagCommon = 1 << iota
+	flagDeprecated
+	flagHistoricalAccurate
+	flagUserAssigned
+	flagHistoricalSince1970
+)
+
+// MarshalBinary encodes the Zone in a compact binary format,
+// eg. for caching or passing it between processes.
+func (z Zone) MarshalBinary() ([]byte, error) {
+	return z.appendBinary([]byte{binaryVersion}), nil
+}
+
+// UnmarshalBinary decodes a Zone encoded by MarshalBinary.
+func (z *Zone) UnmarshalBinary(b []byte) error {
+	r, err := newBinaryReader(b)
+	if err != nil {
+		return err
+	}
+	*z = r.zone()
+	return r.done()
+}
+
+// MarshalBinary encodes the Country, with its Zones, in a compact
+// binary format, eg. for caching or passing it between processes.
+func (c Country) MarshalBinary() ([]byte, error) {
+	return c.appendBinary([]byte{binaryVersion}), nil
+}
+
+// UnmarshalBinary decodes a Country encoded by MarshalBinary.
+func (c *Country) UnmarshalBinary(b []byte) error {
+	r, err := newBinaryReader(b)
+	if err != nil {
+		return err
+	}
+	*c = r.country()
+	return r.done()
+}
+
+// MarshalBinary encodes the Dataset in a compact binary format,
+// eg. for caching or passing it between processes.
+func (d Dataset) MarshalBinary() ([]byte, error) {
+	b := binary.AppendUvarint([]byte{binaryVersion}, uint64(len(d)))
+	for _, c := range d {
+		b = c.appendBinary(b)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes a Dataset encoded by MarshalBinary.
+func (d *Dataset) UnmarshalBinary(b []byte) error {
+	r, err := newBinaryReader(b)
+	if err != nil {
+		return err
+	}
+
+	n := r.count()
+	ds := make(Dataset, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		ds = append(ds, r.country())
+	}
+
+	if err := r.done(); err != nil {
+		return err
+	}
+	*d = ds
+	return nil
+}
+
+func (z Zone) appendBinary(b []byte) []byte {
+	var flags byte
+	if z.Common {
+		flags |= flagCommon
+	}
+	if z.Deprecated {
+		flags |= flagDeprecated
+	}
+	switch z.HistoricalAccuracy {
+	case HistoricalAccurate:
+		flags |= flagHistoricalAccurate
+	case HistoricalSince1970:
+		flags |= flagHistoricalSince1970
+	}
+
+	b = append(b, flags)
+	b = appendString(b, z.CountryCode)
+	return appendString(b, z.Name)
+}
+
+func (c Country) appendBinary(b []byte) []byte {
+	var flags byte
+	if c.UserAssigned {
+		flags |= flagUserAssigned
+	}
+
+	b = append(b, flags)
+	b = appendString(b, c.Code)
+	b = appendString(b, c.Name)
+	b = binary.AppendVarint(b, int64(c.Ordinal))
+
+	b = binary.AppendUvarint(b, uint64(len(c.Synonyms)))
+	for _, s := range c.Synonyms {
+		b = appendString(b, s)
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(c.Zones)))
+	for _, z := range c.Zones {
+		b = z.appendBinary(b)
+	}
+	return b
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// binaryReader decodes the binary encoding, recording
+// the first error, after which it returns zero values.
+type binaryReader struct {
+	b   []byte
+	err error
+}
+
+// newBinaryReader returns a binaryReader of b,
+// after checking its version.
+func newBinaryReader(b []byte) (*binaryReader, error) {
+	if len(b) == 0 || b[0] != binaryVersion {
+		return nil, errBinary
+	}
+	return &binaryReader{b: b[1:]}, nil
+}
+
+// done returns the first error, or an error
+// when data remains unread.
+func (r *binaryReader) done() error {
+	if r.err == nil && len(r.b) > 0 {
+		r.err = errBinary
+	}
+	return r.err
+}
+
+func (r *binaryReader) byte() byte {
+	if r.err != nil || len(r.b) == 0 {
+		r.err = errBinary
+		return 0
+	}
+	v := r.b[0]
+	r.b = r.b[1:]
+	return v
+}
+
+// count returns a length, no longer than the remaining
+// data as each element takes at least a byte.
+func (r *binaryReader) count() int {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 || v > uint64(len(r.b)-n) {
+		r.err = errBinary
+		return 0
+	}
+	r.b = r.b[n:]
+	return int(v)
+}
+
+func (r *binaryReader) varint() int {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.b)
+	if n <= 0 {
+		r.err = errBinary
+		return 0
+	}
+	r.b = r.b[n:]
+	return int(v)
+}
+
+func (r *binaryReader) string() string {
+	n := r.count()
+	if r.err != nil {
+		return ""
+	}
+	s := string(r.b[:n])
+	r.b = r.b[n:]
+	return s
+}
+
+func (r *binaryReader) zone() Zone {
+	flags := r.byte()
+	z := Zone{
+		Common:      flags&flagCommon != 0,
+		Deprecated:  flags&flagDeprecated != 0,
+		CountryCode: r.string(),
+		Name:        r.string(),
+	}
+
+	switch {
+	case flags&flagHistoricalAccurate != 0:
+		z.HistoricalAccuracy = HistoricalAccurate
+	case flags&flagHistoricalSince1970 != 0:
+		z.HistoricalAccuracy = HistoricalSince1970
+	}
+	return z
+}
+
+func (r *binaryReader) country() Country {
+	flags := r.byte()
+
+	c := Country{
+		UserAssigned: flags&flagUserAssigned != 0,
+		Code:         r.string(),
+		Name:         r.string(),
+		Ordinal:      r.varint(),
+	}
+
+	c.Synonyms = make([]string, r.count())
+	for i := range c.Synonyms {
+		c.Synonyms[i] = r.string()
+	}
+
+	c.Zones = make([]Zone, r.count())
+	for i := range c.Zones {
+		c.Zones[i] = r.zone()
+	}
+	return c
+}
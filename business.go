@@ -0,0 +1,179 @@
+package tz
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BusinessCalendar determines the business days and hours of a zone,
+// for SLA calculations across timezones.
+type BusinessCalendar struct {
+	// Location is the location of the zone.
+	Location *time.Location
+
+	// Weekend contains the days that aren't business days.
+	Weekend []time.Weekday
+
+	// Open and Close are the start and end of business hours,
+	// as the time since midnight, eg. 9h and 17h.
+	Open, Close time.Duration
+
+	// Holidays, when set, provides the holidays of CountryCode,
+	// which aren't business days either.
+	Holidays    HolidayProvider
+	CountryCode string
+
+	mu       sync.Mutex
+	holidays map[int]map[time.Time]bool
+}
+
+// NewBusinessCalendar returns the BusinessCalendar of the zone name
+// passed with the weekend days passed, eg. Friday and Saturday for
+// Saudi Arabia, business hours from 9:00 to 17:00 and the holidays,
+// if any, provided by holidays. Weekends differing by country, there
+// is no default; weekend must be non-nil, empty for none.
+func NewBusinessCalendar(zone string, weekend []time.Weekday, holidays HolidayProvider) (*BusinessCalendar, error) {
+	load()
+
+	if weekend == nil {
+		return nil, fmt.Errorf("tz: no weekend days passed for zone %q", zone)
+	}
+
+	z, found := zones[zone]
+	if !found {
+		return nil, fmt.Errorf("tz: unknown zone %q", zone)
+	}
+
+	loc, err := LoadLocation(z.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BusinessCalendar{
+		Location:    loc,
+		Weekend:     append([]time.Weekday(nil), weekend...),
+		Open:        9 * time.Hour,
+		Close:       17 * time.Hour,
+		Holidays:    holidays,
+		CountryCode: z.CountryCode,
+	}, nil
+}
+
+// IsBusinessDay returns whether the day of t, in the calendar's
+// zone, is neither a weekend day nor a holiday.
+func (bc *BusinessCalendar) IsBusinessDay(t time.Time) (bool, error) {
+	t = t.In(bc.Location)
+
+	for _, d := range bc.Weekend {
+		if t.Weekday() == d {
+			return false, nil
+		}
+	}
+
+	if bc.Holidays == nil {
+		return true, nil
+	}
+
+	holidays, err := bc.holidaysOf(t.Year())
+	if err != nil {
+		return false, err
+	}
+
+	return !holidays[time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)], nil
+}
+
+// IsBusinessHour returns whether t is within business hours,
+// from Open until Close, of a business day in the calendar's zone.
+func (bc *BusinessCalendar) IsBusinessHour(t time.Time) (bool, error) {
+	t = t.In(bc.Location)
+
+	ok, err := bc.IsBusinessDay(t)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	open := bc.wallClock(t, bc.Open)
+	close := bc.wallClock(t, bc.Close)
+
+	return !t.Before(open) && t.Before(close), nil
+}
+
+// wallClock returns the instant the wall clock reads d since
+// midnight on the day of t in the calendar's zone, eg. 9:00 for 9h
+// even on DST transition days, when that's not 9h after midnight.
+func (bc *BusinessCalendar) wallClock(t time.Time, d time.Duration) time.Time {
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	ns := d % time.Second
+
+	return time.Date(t.Year(), t.Month(), t.Day(), int(h), int(m), int(s), int(ns), bc.Location)
+}
+
+// AddBusinessDays returns t moved n business days forward, or
+// backward when n is negative, keeping its wall clock time in
+// the calendar's zone.
+func (bc *BusinessCalendar) AddBusinessDays(t time.Time, n int) (time.Time, error) {
+	from := t.In(bc.Location)
+	t = from
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	// a year without a business day means there are none,
+	// eg. all days being weekend days or holidays.
+	skipped := 0
+
+	for days := step; n > 0; days += step {
+		// the wall clock time of from, rather than of the previous
+		// day, which is shifted when that falls in a DST gap.
+		t = time.Date(from.Year(), from.Month(), from.Day()+days, from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), bc.Location)
+
+		ok, err := bc.IsBusinessDay(t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			n--
+			skipped = 0
+			continue
+		}
+
+		if skipped++; skipped > 366 {
+			return time.Time{}, fmt.Errorf("tz: no business day within a year of %s", from.Format("2006-01-02"))
+		}
+	}
+
+	return t, nil
+}
+
+// holidaysOf returns the dates of the holidays in year,
+// retrieving them from Holidays once per year.
+func (bc *BusinessCalendar) holidaysOf(year int) (map[time.Time]bool, error) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	if dates, ok := bc.holidays[year]; ok {
+		return dates, nil
+	}
+
+	hs, err := bc.Holidays.Holidays(bc.CountryCode, year)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[time.Time]bool, len(hs))
+	for _, h := range hs {
+		dates[time.Date(h.Date.Year(), h.Date.Month(), h.Date.Day(), 0, 0, 0, 0, time.UTC)] = true
+	}
+
+	if bc.holidays == nil {
+		bc.holidays = make(map[int]map[time.Time]bool)
+	}
+	bc.holidays[year] = dates
+
+	return dates, nil
+}
@@ -0,0 +1,235 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+var satSun = []time.Weekday{time.Saturday, time.Sunday}
+
+type testHolidays map[string][]Holiday
+
+func (h testHolidays) Holidays(countryCode string, year int) ([]Holiday, error) {
+	var hs []Holiday
+	for _, hol := range h[countryCode] {
+		if hol.Date.Year() == year {
+			hs = append(hs, hol)
+		}
+	}
+	return hs, nil
+}
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestIsBusinessHour(t *testing.T) {
+	london := mustLocation(t, "Europe/London")
+	riyadh := mustLocation(t, "Asia/Riyadh")
+
+	tests := []struct {
+		name    string
+		zone    string
+		weekend []time.Weekday
+		t       time.Time
+		want    bool
+	}{
+		{
+			name:    "spring forward day after opening",
+			zone:    "Europe/London",
+			weekend: []time.Weekday{},
+			t:       time.Date(2024, time.March, 31, 9, 30, 0, 0, london),
+			want:    true,
+		},
+		{
+			name:    "fall back day before closing",
+			zone:    "Europe/London",
+			weekend: []time.Weekday{},
+			t:       time.Date(2024, time.October, 27, 16, 30, 0, 0, london),
+			want:    true,
+		},
+		{
+			name:    "fall back day after closing",
+			zone:    "Europe/London",
+			weekend: []time.Weekday{},
+			t:       time.Date(2024, time.October, 27, 17, 30, 0, 0, london),
+			want:    false,
+		},
+		{
+			name:    "before opening",
+			zone:    "Europe/London",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 4, 8, 59, 0, 0, london),
+			want:    false,
+		},
+		{
+			name:    "Saturday weekend",
+			zone:    "Europe/London",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 2, 10, 0, 0, 0, london),
+			want:    false,
+		},
+		{
+			name:    "Friday weekend",
+			zone:    "Asia/Riyadh",
+			weekend: []time.Weekday{time.Friday, time.Saturday},
+			t:       time.Date(2024, time.March, 1, 10, 0, 0, 0, riyadh),
+			want:    false,
+		},
+		{
+			name:    "Sunday business day",
+			zone:    "Asia/Riyadh",
+			weekend: []time.Weekday{time.Friday, time.Saturday},
+			t:       time.Date(2024, time.March, 3, 10, 0, 0, 0, riyadh),
+			want:    true,
+		},
+		{
+			name:    "other zone instant",
+			zone:    "Europe/London",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC).In(riyadh),
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc, err := NewBusinessCalendar(tt.zone, tt.weekend, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := bc.IsBusinessHour(tt.t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	newYork := mustLocation(t, "America/New_York")
+
+	holidays := testHolidays{
+		"US": {{CountryCode: "US", Name: "Independence Day", Date: time.Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	tests := []struct {
+		name    string
+		weekend []time.Weekday
+		t       time.Time
+		n       int
+		want    time.Time
+	}{
+		{
+			name:    "zero",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 6, 10, 0, 0, 0, time.UTC),
+			n:       0,
+			want:    time.Date(2024, time.March, 6, 5, 0, 0, 0, newYork),
+		},
+		{
+			name:    "over the weekend",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 1, 10, 0, 0, 0, newYork),
+			n:       1,
+			want:    time.Date(2024, time.March, 4, 10, 0, 0, 0, newYork),
+		},
+		{
+			name:    "over the spring forward gap",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 8, 2, 30, 0, 0, newYork),
+			n:       2,
+			want:    time.Date(2024, time.March, 12, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:    "onto the spring forward gap",
+			weekend: []time.Weekday{},
+			t:       time.Date(2024, time.March, 9, 2, 30, 0, 0, newYork),
+			n:       1,
+			// normalized as by time.Date, the wall clock being
+			// restored the day after.
+			want: time.Date(2024, time.March, 10, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:    "over the fall back overlap",
+			weekend: satSun,
+			t:       time.Date(2024, time.November, 1, 1, 30, 0, 0, newYork),
+			n:       1,
+			want:    time.Date(2024, time.November, 4, 1, 30, 0, 0, newYork),
+		},
+		{
+			name:    "backward over the weekend",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 4, 10, 0, 0, 0, newYork),
+			n:       -1,
+			want:    time.Date(2024, time.March, 1, 10, 0, 0, 0, newYork),
+		},
+		{
+			name:    "backward over the spring forward gap",
+			weekend: satSun,
+			t:       time.Date(2024, time.March, 12, 2, 30, 0, 0, newYork),
+			n:       -2,
+			want:    time.Date(2024, time.March, 8, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:    "over a holiday",
+			weekend: satSun,
+			t:       time.Date(2024, time.July, 3, 9, 0, 0, 0, newYork),
+			n:       1,
+			want:    time.Date(2024, time.July, 5, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:    "backward over a holiday",
+			weekend: satSun,
+			t:       time.Date(2024, time.July, 5, 9, 0, 0, 0, newYork),
+			n:       -1,
+			want:    time.Date(2024, time.July, 3, 9, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc, err := NewBusinessCalendar("America/New_York", tt.weekend, holidays)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := bc.AddBusinessDays(tt.t, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) || got.Location().String() != newYork.String() {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDaysNone(t *testing.T) {
+	bc, err := NewBusinessCalendar("America/New_York", []time.Weekday{0, 1, 2, 3, 4, 5, 6}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []int{1, -1} {
+		if _, err := bc.AddBusinessDays(time.Now(), n); err == nil {
+			t.Errorf("n %d: got nil, want error", n)
+		}
+	}
+}
+
+func TestNewBusinessCalendarWeekend(t *testing.T) {
+	if _, err := NewBusinessCalendar("Asia/Riyadh", nil, nil); err == nil {
+		t.Error("got nil, want error")
+	}
+}
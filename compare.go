@@ -0,0 +1,47 @@
+package tz
+
+import (
+	"strings"
+	"time"
+)
+
+// ByCountryName returns a comparison of countries by name in the
+// locale passed, eg. "en", ignoring case and accents, then by code,
+// for use with slices.SortFunc and the like. Names are only available
+// in English for now, so every locale compares as English.
+func ByCountryName(locale string) func(a, b Country) int {
+	return func(a, b Country) int {
+		if c := strings.Compare(strings.ToLower(fold(a.Name)), strings.ToLower(fold(b.Name))); c != 0 {
+			return c
+		}
+		return ByCountryCode(a, b)
+	}
+}
+
+// ByCountryCode compares countries by code, for use with
+// slices.SortFunc and the like.
+func ByCountryCode(a, b Country) int {
+	return strings.Compare(a.Code, b.Code)
+}
+
+// ByZoneOffset returns a comparison of zones by their offset at the
+// instant passed, west to east, then by name, for use with
+// slices.SortFunc and the like.
+func ByZoneOffset(at time.Time) func(a, b Zone) int {
+	return func(a, b Zone) int {
+		if c := zoneOffset(a, at).Compare(zoneOffset(b, at)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	}
+}
+
+// zoneOffset returns the Offset of z at t,
+// zero when its location can't be loaded.
+func zoneOffset(z Zone, t time.Time) Offset {
+	loc, err := LoadLocation(z.Name)
+	if err != nil {
+		return 0
+	}
+	return OffsetOf(t.In(loc))
+}
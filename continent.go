@@ -0,0 +1,142 @@
+package tz
+
+import (
+	"sort"
+	"strings"
+)
+
+// Continent is a continent a Zone, and so a Country, lies on.
+type Continent string
+
+// Continents
+const (
+	Africa     Continent = "Africa"
+	America    Continent = "America"
+	Antarctica Continent = "Antarctica"
+	Asia       Continent = "Asia"
+	Europe     Continent = "Europe"
+	Oceania    Continent = "Oceania"
+)
+
+// areaContinents maps the area prefix of zone names to their Continent.
+var areaContinents = map[string]Continent{
+	"Africa":     Africa,
+	"America":    America,
+	"Antarctica": Antarctica,
+	"Arctic":     Europe,
+	"Asia":       Asia,
+	"Australia":  Oceania,
+	"Europe":     Europe,
+	"Pacific":    Oceania,
+}
+
+// oceanContinents maps the codes of countries with Atlantic, Indian
+// or Pacific ocean zones to the Continent those zones belong to.
+// Pacific zones of countries not listed belong to Oceania.
+var oceanContinents = map[string]Continent{
+	"BM": America,
+	"CC": Oceania,
+	"CL": America,
+	"CV": Africa,
+	"CX": Oceania,
+	"EC": America,
+	"ES": Europe,
+	"FK": America,
+	"FO": Europe,
+	"GS": America,
+	"IO": Asia,
+	"IS": Europe,
+	"KM": Africa,
+	"MG": Africa,
+	"MU": Africa,
+	"MV": Asia,
+	"PT": Europe,
+	"RE": Africa,
+	"SC": Africa,
+	"SH": Africa,
+	"TF": Africa,
+	"YT": Africa,
+}
+
+// territoryContinents contains the continents of countries that
+// aren't derivable from their zones; those spanning continents
+// with a single zone and those without zones.
+var territoryContinents = map[string][]Continent{
+	"BV": {Antarctica},
+	"EG": {Asia},
+	"HM": {Antarctica},
+	"TR": {Asia},
+}
+
+// Continent returns the Continent the zone lies on, derived from
+// the area prefix of its name, and for ocean zones its country.
+// Zones of other areas, eg. registered with RegisterZone, lie on
+// the continent of their country's zones.
+func (z Zone) Continent() Continent {
+	if c, ok := z.areaContinent(); ok {
+		return c
+	}
+
+	load()
+
+	for _, cz := range mapped[z.CountryCode].Zones {
+		if c, ok := cz.areaContinent(); ok {
+			return c
+		}
+	}
+
+	if conts := territoryContinents[z.CountryCode]; len(conts) > 0 {
+		return conts[0]
+	}
+
+	return Oceania
+}
+
+// areaContinent returns the Continent of the zone's area prefix
+// and whether the area is known.
+func (z Zone) areaContinent() (c Continent, found bool) {
+	area := z.Name
+	if i := strings.IndexByte(area, '/'); i >= 0 {
+		area = area[:i]
+	}
+
+	switch area {
+	case "Atlantic", "Indian", "Pacific":
+		if c, found = oceanContinents[z.CountryCode]; found {
+			return
+		}
+		return Oceania, true
+	}
+
+	c, found = areaContinents[area]
+	return
+}
+
+// Continents returns the continents the country lies on, sorted
+// alphabetically; more than one for transcontinental countries
+// such as Russia and Turkey.
+func (c Country) Continents() []Continent {
+	seen := make(map[Continent]bool)
+	var continents []Continent
+
+	add := func(cont Continent) {
+		if !seen[cont] {
+			seen[cont] = true
+			continents = append(continents, cont)
+		}
+	}
+
+	for _, z := range c.Zones {
+		add(z.Continent())
+	}
+
+	for _, cont := range territoryContinents[c.Code] {
+		add(cont)
+	}
+
+	sort.Slice(continents, func(i, j int) bool {
+		return continents[i] < continents[j]
+	})
+
+	return continents
+}
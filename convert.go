@@ -0,0 +1,41 @@
+package tz
+
+import (
+	"errors"
+	"time"
+)
+
+// ZoneTime is an instant in a zone.
+type ZoneTime struct {
+	Zone string
+	Time time.Time
+}
+
+// ConvertAll returns t in each of the zone names passed, in the same
+// order, eg. for fanning out a send at 9am local. All zones are
+// validated before converting, the error joining a *ZoneError for
+// each unknown zone. Locations are cached as by LoadLocation.
+func ConvertAll(t time.Time, zones []string) ([]ZoneTime, error) {
+	locs := make([]*time.Location, len(zones))
+
+	var errs []error
+
+	for i, zone := range zones {
+		loc, err := zoneLocation(zone)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		locs[i] = loc
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	zts := make([]ZoneTime, len(zones))
+	for i, zone := range zones {
+		zts[i] = ZoneTime{Zone: zone, Time: t.In(locs[i])}
+	}
+	return zts, nil
+}
@@ -0,0 +1,237 @@
+package tz
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// customMu serializes the modifications of the package data.
+	customMu sync.Mutex
+
+	// customLocations contains the locations of the zones
+	// registered with RegisterZone.
+	customLocations map[string]*time.Location
+)
+
+// RegisterZone adds the zone z, with the location loc, to the country
+// of the code passed, eg. an organization specific "Ship/Atlantic-Fleet"
+// of time.FixedZone, after which it's returned by the lookup and
+// search functions like any other zone. Having no tzdata, it can't
+// be exported with ExportTZif or VTimezone.
+// It must be called before the package is used concurrently, eg. from
+// an init function, as lookups don't synchronize with it.
+func RegisterZone(countryCode string, z Zone, loc *time.Location) error {
+	load()
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	if z.Name == "" {
+		return fmt.Errorf("tz: RegisterZone zone name is empty")
+	}
+	if loc == nil {
+		return fmt.Errorf("tz: RegisterZone location of %q is nil", z.Name)
+	}
+
+	c, found := mapped[countryCode]
+	if !found {
+		return fmt.Errorf("tz: unknown country code %q", countryCode)
+	}
+
+	if _, found := zones[z.Name]; found {
+		return fmt.Errorf("tz: zone %q already exists", z.Name)
+	}
+
+	z.CountryCode = countryCode
+
+	zs := make([]Zone, len(c.Zones), len(c.Zones)+1)
+	copy(zs, c.Zones)
+	c.Zones = append(zs, z)
+
+	setCountry(c)
+
+	if customLocations == nil {
+		customLocations = make(map[string]*time.Location)
+	}
+	customLocations[z.Name] = loc
+
+	// a location cached for the name before it was registered
+	// must not shadow loc.
+	locations.Load().delete(z.Name)
+
+	reindex()
+	return nil
+}
+
+// setCountry replaces the Country of the same code in the package
+// data with c.
+func setCountry(c Country) {
+	mapped[c.Code] = c
+
+	for i := range countries {
+		if countries[i].Code == c.Code {
+			countries[i] = c
+			return
+		}
+	}
+}
+
+// reindex rebuilds the indexes of the package data
+// after it has been modified.
+func reindex() {
+	indexZones()
+	indexSlugs()
+	indexSearch()
+
+	abbreviationsOnce = sync.Once{}
+}
+
+var (
+	// originalNames contains the names of the countries
+	// overridden with OverrideCountryName, by code.
+	originalNames map[string]string
+
+	// zoneDisplayNames contains the display names set
+	// with OverrideZoneDisplayName, by zone name.
+	zoneDisplayNames map[string]string
+)
+
+// OverrideCountryName replaces the name of the country of the code
+// passed, eg. for naming requirements of white-label deployments,
+// throughout the lookup, search and export functions. An empty name
+// restores the original. As with RegisterZone, it must be called
+// before the package is used concurrently.
+func OverrideCountryName(code, name string) error {
+	load()
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	c, found := mapped[code]
+	if !found {
+		return fmt.Errorf("tz: unknown country code %q", code)
+	}
+
+	original, overridden := originalNames[code]
+
+	switch {
+	case name == "" && !overridden:
+		return nil
+	case name == "":
+		name = original
+		delete(originalNames, code)
+	case !overridden:
+		if originalNames == nil {
+			originalNames = make(map[string]string)
+		}
+		originalNames[code] = c.Name
+	}
+
+	c.Name = name
+	setCountry(c)
+
+	reindex()
+	return nil
+}
+
+// OverrideZoneDisplayName replaces the name returned by DisplayName
+// for the zone name passed. An empty name restores the original.
+// As with RegisterZone, it must be called before the package is used
+// concurrently.
+func OverrideZoneDisplayName(zone, name string) error {
+	load()
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	if _, found := zones[zone]; !found {
+		return fmt.Errorf("tz: unknown zone %q", zone)
+	}
+
+	if name == "" {
+		delete(zoneDisplayNames, zone)
+		return nil
+	}
+
+	if zoneDisplayNames == nil {
+		zoneDisplayNames = make(map[string]string)
+	}
+	zoneDisplayNames[zone] = name
+	return nil
+}
+
+// DataSnapshot is the state of the package data, including the
+// modifications of RegisterZone and the Override functions, as
+// taken by Snapshot.
+type DataSnapshot struct {
+	countries        []Country
+	locations        map[string]*time.Location
+	originalNames    map[string]string
+	zoneDisplayNames map[string]string
+}
+
+// Snapshot returns the current state of the package data, for rolling
+// back modifications with Restore, eg. between test cases or tenants.
+// As with RegisterZone, it must be called while the package isn't used
+// concurrently.
+func Snapshot() DataSnapshot {
+	load()
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	// the modifications replace Countries rather than modifying
+	// them, so copying the slice is enough.
+	return DataSnapshot{
+		countries:        append([]Country(nil), countries...),
+		locations:        cloneMap(customLocations),
+		originalNames:    cloneMap(originalNames),
+		zoneDisplayNames: cloneMap(zoneDisplayNames),
+	}
+}
+
+// Restore rolls the package data back to the state of the DataSnapshot
+// passed, undoing the modifications made since it was taken; the zero
+// DataSnapshot is ignored. As with RegisterZone, it must be called
+// while the package isn't used concurrently.
+func Restore(s DataSnapshot) {
+	if s.countries == nil {
+		return
+	}
+
+	load()
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	// the locations of zones registered since may be cached.
+	cache := locations.Load()
+	for name := range customLocations {
+		cache.delete(name)
+	}
+
+	countries = append([]Country(nil), s.countries...)
+	mapped = make(map[string]Country, len(countries))
+	for i := 0; i < len(countries); i++ {
+		mapped[countries[i].Code] = countries[i]
+	}
+
+	customLocations = cloneMap(s.locations)
+	originalNames = cloneMap(s.originalNames)
+	zoneDisplayNames = cloneMap(s.zoneDisplayNames)
+
+	reindex()
+}
+
+func cloneMap[V any](m map[string]V) map[string]V {
+	if m == nil {
+		return nil
+	}
+	clone := make(map[string]V, len(m))
+	for k, v := range m {
+		clone[k] = v
+	}
+	return clone
+}
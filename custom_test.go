@@ -0,0 +1,74 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	s := Snapshot()
+	defer Restore(s)
+
+	loc := time.FixedZone("Fleet", -3*60*60)
+
+	if err := RegisterZone("US", Zone{Name: "Ship/Atlantic-Fleet"}, loc); err != nil {
+		t.Fatal(err)
+	}
+	if err := OverrideCountryName("US", "US of A"); err != nil {
+		t.Fatal(err)
+	}
+	if err := OverrideZoneDisplayName("America/New_York", "Eastern"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a tenant's modifications on top of the first ones.
+	tenant := Snapshot()
+
+	if err := OverrideCountryName("US", "USA"); err != nil {
+		t.Fatal(err)
+	}
+
+	Restore(tenant)
+
+	if c := MustCountry("US"); c.Name != "US of A" {
+		t.Errorf("tenant: got name %q, want %q", c.Name, "US of A")
+	}
+	if _, found := GetZone("Ship/Atlantic-Fleet"); !found {
+		t.Error("tenant: registered zone not found")
+	}
+
+	Restore(s)
+
+	if c := MustCountry("US"); c.Name != "United States of America" {
+		t.Errorf("got name %q, want %q", c.Name, "United States of America")
+	}
+	if _, found := GetZone("Ship/Atlantic-Fleet"); found {
+		t.Error("registered zone found after Restore")
+	}
+	if _, err := LoadLocation("Ship/Atlantic-Fleet"); err == nil {
+		t.Error("registered zone location loaded after Restore")
+	}
+	if z := MustZone("America/New_York"); z.DisplayName() != "New York (America)" {
+		t.Errorf("got display name %q, want %q", z.DisplayName(), "New York (America)")
+	}
+	for _, z := range MustCountry("US").Zones {
+		if z.Name == "Ship/Atlantic-Fleet" {
+			t.Error("registered zone in the Zones of US after Restore")
+		}
+	}
+}
+
+func TestRestoreZero(t *testing.T) {
+	s := Snapshot()
+	defer Restore(s)
+
+	if err := OverrideCountryName("US", "USA"); err != nil {
+		t.Fatal(err)
+	}
+
+	Restore(DataSnapshot{})
+
+	if c := MustCountry("US"); c.Name != "USA" {
+		t.Errorf("got name %q, want %q", c.Name, "USA")
+	}
+}
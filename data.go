@@ -0,0 +1,67 @@
+package tz
+
+import "sync"
+
+var (
+	once      sync.Once
+	countries []Country
+	mapped    map[string]Country
+)
+
+// load decodes the countries on first use and indexes them
+// for the lookup functions; it's called by every function
+// reading the package data.
+func load() {
+	once.Do(func() {
+		countries, mapped = loadData()
+
+		// names are omitted when generated with -strip-names.
+		for i := 0; i < len(countries); i++ {
+			if countries[i].Name == "" {
+				countries[i].Name = countries[i].Code
+			}
+		}
+		for code, c := range mapped {
+			if c.Name == "" {
+				c.Name = code
+				mapped[code] = c
+			}
+		}
+
+		if mapped == nil {
+			mapped = make(map[string]Country, len(countries))
+
+			for i := 0; i < len(countries); i++ {
+				mapped[countries[i].Code] = countries[i]
+			}
+		}
+
+		indexZones()
+		indexSlugs()
+		indexSearch()
+	})
+}
+
+// GetCountries returns an array of all countries.
+// Most common use: for loading into a country dropdown
+// in HTML.
+// Options such as WithoutZones control the Zones returned.
+func GetCountries(opts ...Option) []Country {
+	load()
+
+	cs := make([]Country, len(countries))
+	for i := 0; i < len(countries); i++ {
+		cs[i] = countries[i].withOptions(opts)
+	}
+	return cs
+}
+
+// GetCountry returns a single Country that matches the country
+// code passed and whether it was found, Options such as
+// WithoutZones controlling the Zones returned.
+func GetCountry(code string, opts ...Option) (c Country, found bool) {
+	load()
+
+	c, found = mapped[code]
+	return c.withOptions(opts), found
+}
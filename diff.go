@@ -0,0 +1,180 @@
+package tz
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Dataset is a release of the data, eg. the compiled in data
+// returned by CurrentDataset or an older tz_data.json read by
+// ReadDataset.
+type Dataset []Country
+
+// CurrentDataset returns the compiled in data.
+func CurrentDataset() Dataset {
+	return GetCountries()
+}
+
+// ReadDataset reads a Dataset from the JSON output of the generator,
+// eg. the tz_data.json of a release.
+func ReadDataset(r io.Reader) (Dataset, error) {
+	var d Dataset
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("tz: reading dataset: %w", err)
+	}
+	return d, nil
+}
+
+// ReadVerifiedDataset reads a Dataset as ReadDataset, after verifying
+// it against the SHA-256 manifest written alongside it by the
+// generator, eg. the tz_data.json.sha256 of a release.
+func ReadVerifiedDataset(r, manifest io.Reader) (Dataset, error) {
+	m, err := io.ReadAll(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("tz: reading manifest: %w", err)
+	}
+
+	// in the format of sha256sum, the checksum followed by the name.
+	fields := strings.Fields(string(m))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("tz: reading manifest: no checksum")
+	}
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("tz: reading dataset: %w", err)
+	}
+
+	sum := sha256.Sum256(b)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), fields[0]) {
+		return nil, fmt.Errorf("tz: dataset does not match its manifest checksum %s", fields[0])
+	}
+
+	return ReadDataset(bytes.NewReader(b))
+}
+
+// CountryRename is a country whose name changed between Datasets.
+type CountryRename struct {
+	Code     string
+	From, To string
+}
+
+// ZoneRename is a zone of a country whose name changed between
+// Datasets, eg. Europe/Kiev to Europe/Kyiv.
+type ZoneRename struct {
+	CountryCode string
+	From, To    string
+}
+
+// Report contains the changes between two Datasets.
+type Report struct {
+	AddedCountries   []Country
+	RemovedCountries []Country
+	RenamedCountries []CountryRename
+
+	AddedZones   []Zone
+	RemovedZones []Zone
+	RenamedZones []ZoneRename
+}
+
+// Empty returns whether there are no changes.
+func (r Report) Empty() bool {
+	return len(r.AddedCountries) == 0 && len(r.RemovedCountries) == 0 && len(r.RenamedCountries) == 0 &&
+		len(r.AddedZones) == 0 && len(r.RemovedZones) == 0 && len(r.RenamedZones) == 0
+}
+
+// Diff returns the countries and zones added, removed or renamed from
+// a to b, eg. before enabling a new release. Zones are renamed when
+// the removed and added zones of a country alias one another.
+func Diff(a, b Dataset) Report {
+	var r Report
+
+	old := make(map[string]Country, len(a))
+	for _, c := range a {
+		old[c.Code] = c
+	}
+
+	cur := make(map[string]Country, len(b))
+	for _, c := range b {
+		cur[c.Code] = c
+	}
+
+	for _, c := range b {
+		prev, ok := old[c.Code]
+		if !ok {
+			r.AddedCountries = append(r.AddedCountries, c)
+		} else if prev.Name != c.Name {
+			r.RenamedCountries = append(r.RenamedCountries, CountryRename{Code: c.Code, From: prev.Name, To: c.Name})
+		}
+
+		r.diffZones(c.Code, prev.Zones, c.Zones)
+	}
+
+	for _, c := range a {
+		if _, ok := cur[c.Code]; !ok {
+			r.RemovedCountries = append(r.RemovedCountries, c)
+			r.diffZones(c.Code, c.Zones, nil)
+		}
+	}
+
+	return r
+}
+
+// diffZones adds the zones added, removed or renamed from
+// a to b within the single country code to r.
+func (r *Report) diffZones(code string, a, b []Zone) {
+	old := make(map[string]bool, len(a))
+	for _, z := range a {
+		old[z.Name] = true
+	}
+
+	cur := make(map[string]bool, len(b))
+	for _, z := range b {
+		cur[z.Name] = true
+	}
+
+	var added, removed []Zone
+
+	for _, z := range b {
+		if !old[z.Name] {
+			added = append(added, z)
+		}
+	}
+
+	for _, z := range a {
+		if !cur[z.Name] {
+			removed = append(removed, z)
+		}
+	}
+
+	renamed := make(map[string]bool)
+
+	for _, from := range removed {
+		for _, to := range added {
+			if renamed[to.Name] || (zoneAliases[from.Name] != to.Name && zoneAliases[to.Name] != from.Name) {
+				continue
+			}
+			r.RenamedZones = append(r.RenamedZones, ZoneRename{CountryCode: code, From: from.Name, To: to.Name})
+			renamed[from.Name] = true
+			renamed[to.Name] = true
+			break
+		}
+	}
+
+	for _, z := range added {
+		if !renamed[z.Name] {
+			r.AddedZones = append(r.AddedZones, z)
+		}
+	}
+
+	for _, z := range removed {
+		if !renamed[z.Name] {
+			r.RemovedZones = append(r.RemovedZones, z)
+		}
+	}
+}
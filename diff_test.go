@@ -0,0 +1,35 @@
+package tz
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadVerifiedDataset(t *testing.T) {
+	data, err := os.ReadFile("tz_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := os.ReadFile("tz_data.json.sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := ReadVerifiedDataset(strings.NewReader(string(data)), strings.NewReader(string(manifest)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d) != len(CurrentDataset()) {
+		t.Errorf("got %d countries, want %d", len(d), len(CurrentDataset()))
+	}
+
+	tampered := strings.Replace(string(data), `"US"`, `"UZ"`, 1)
+
+	if _, err := ReadVerifiedDataset(strings.NewReader(tampered), strings.NewReader(string(manifest))); err == nil {
+		t.Error("tampered: got nil, want error")
+	}
+	if _, err := ReadVerifiedDataset(strings.NewReader(string(data)), strings.NewReader("")); err == nil {
+		t.Error("empty manifest: got nil, want error")
+	}
+}
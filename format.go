@@ -0,0 +1,42 @@
+package tz
+
+import "time"
+
+// rfc2822 is the RFC 2822 date-time layout, eg. as used in email.
+const rfc2822 = "Mon, 02 Jan 2006 15:04:05 -0700"
+
+// FormatInZone returns t in the zone name passed formatted per
+// layout, as time.Time.Format does. The zone must be one of the
+// package's zones, else a *ZoneError is returned; its location is
+// cached as by LoadLocation.
+func FormatInZone(t time.Time, zone, layout string) (string, error) {
+	loc, err := zoneLocation(zone)
+	if err != nil {
+		return "", err
+	}
+	return t.In(loc).Format(layout), nil
+}
+
+// RFC3339InZone returns t in the zone name passed formatted
+// per RFC 3339, eg. "2006-01-02T15:04:05-07:00".
+func RFC3339InZone(t time.Time, zone string) (string, error) {
+	return FormatInZone(t, zone, time.RFC3339)
+}
+
+// RFC2822InZone returns t in the zone name passed formatted
+// per RFC 2822, eg. "Mon, 02 Jan 2006 15:04:05 -0700".
+func RFC2822InZone(t time.Time, zone string) (string, error) {
+	return FormatInZone(t, zone, rfc2822)
+}
+
+// zoneLocation returns the cached location of the zone name
+// passed, which must be one of the package's zones.
+func zoneLocation(zone string) (*time.Location, error) {
+	load()
+
+	if _, found := zones[zone]; !found {
+		return nil, &ZoneError{Input: zone, Suggestions: suggestZones(zone, maxSuggestions)}
+	}
+
+	return LoadLocation(zone)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/go-playground/tz"
+)
+
+// aliasesGoFile is the file the zone aliases are written to
+// alongside the Go output.
+const aliasesGoFile = "tz_aliases.go"
+
+var aliasesOutput = `package {{ .Package }}
+
+// GENERATED FILE DO NOT MODIFY DIRECTLY
+
+// zoneAliases maps the links of the IANA backward file that aren't
+// zones of the data, or are Deprecated ones, eg. "US/Eastern", and the
+// current names of zones the data holds under a former one, eg.
+// "Europe/Kyiv", to the zone of the data they resolve to.
+var zoneAliases = map[string]string{
+	{{ range $alias, $zone := .Aliases }}{{ printf "%q" $alias }}: {{ printf "%q" $zone }},
+	{{ end }}
+}
+`
+
+var aliasesEmitter = templateEmitter{
+	tmpl:  template.Must(template.New("aliases").Parse(aliasesOutput)),
+	ext:   ".go",
+	gofmt: true,
+}
+
+// zoneAliases returns the aliases of the zones of countries per links,
+// as described by the generated zoneAliases.
+func zoneAliases(countries []tz.Country, links backwardLinks) map[string]string {
+	deprecated := make(map[string]bool)
+	for _, c := range countries {
+		for _, z := range c.Zones {
+			deprecated[z.Name] = z.Deprecated
+		}
+	}
+
+	// zones of the data that are themselves links, eg. Europe/Kiev,
+	// stand in for the zone they link to, eg. Europe/Kyiv.
+	equivalents := make(map[string]string)
+	for name, dep := range deprecated {
+		target, ok := links[name]
+		if !ok || dep {
+			continue
+		}
+		if prev, ok := equivalents[target]; !ok || name < prev {
+			equivalents[target] = name
+		}
+	}
+
+	aliases := make(map[string]string)
+
+	for name, target := range links {
+		if dep, ok := deprecated[name]; ok && !dep {
+			continue
+		}
+
+		if _, ok := deprecated[target]; !ok || deprecated[target] {
+			target = equivalents[target]
+		}
+		if target == "" || target == name {
+			continue
+		}
+
+		aliases[name] = target
+	}
+
+	for target, name := range equivalents {
+		if _, ok := deprecated[target]; !ok {
+			aliases[target] = name
+		}
+	}
+
+	return aliases
+}
+
+// writeAliases writes the Go file of the zone aliases of data to dir.
+func writeAliases(dir string, data templateData) error {
+	src, err := aliasesEmitter.Emit(data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, aliasesGoFile), src, 0644)
+}
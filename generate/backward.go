@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/tz"
+)
+
+// backwardLinks maps the backward-compatibility zone names of
+// the IANA backward file to the zones they link to.
+type backwardLinks map[string]string
+
+// preferredLinks contains the "#= zone" comments of the IANA backward
+// file, as of 2025b, for the files without them, eg. tzdata.zi, which
+// drops comments.
+var preferredLinks = map[string]string{
+	"Africa/Asmera":         "Africa/Asmara",
+	"Africa/Timbuktu":       "Africa/Bamako",
+	"America/Coral_Harbour": "America/Atikokan",
+	"America/Kralendijk":    "America/Curacao",
+	"America/Lower_Princes": "America/Curacao",
+	"America/Marigot":       "America/Port_of_Spain",
+	"America/St_Barthelemy": "America/Port_of_Spain",
+	"America/Virgin":        "America/St_Thomas",
+	"Antarctica/South_Pole": "Antarctica/McMurdo",
+	"Arctic/Longyearbyen":   "Europe/Oslo",
+	"Atlantic/Jan_Mayen":    "Arctic/Longyearbyen",
+	"Iceland":               "Atlantic/Reykjavik",
+	"Pacific/Ponape":        "Pacific/Pohnpei",
+	"Pacific/Truk":          "Pacific/Chuuk",
+	"Pacific/Yap":           "Pacific/Chuuk",
+}
+
+// readBackward reads the links of the IANA backward file, or of
+// a tzdata.zi file, which also holds the links of the other files.
+// Links of the backward file commented "#= zone", eg. Iceland to
+// Africa/Abidjan "#= Atlantic/Reykjavik", link to the zone of the
+// comment, the one of their region, per preferredLinks when the file
+// has no such comments.
+func readBackward(filename string) (backwardLinks, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	links := make(backwardLinks)
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line, preferred := s.Text(), ""
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			if comment := line[i+1:]; strings.HasPrefix(comment, "=") {
+				preferred = strings.TrimSpace(comment[1:])
+			}
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 || (fields[0] != "Link" && fields[0] != "L") {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid link line %q", s.Text())
+		}
+
+		links[fields[2]] = fields[1]
+		if preferred == "" {
+			preferred = preferredLinks[fields[2]]
+		}
+		if preferred != "" {
+			links[fields[2]] = preferred
+		}
+	}
+
+	return links, s.Err()
+}
+
+// backzoneZones contains the zones of the IANA backzone file,
+// which tzdb otherwise folds into links as identical since 1970.
+type backzoneZones map[string]bool
+
+// readBackzone reads the names of the zones of the IANA backzone file.
+func readBackzone(filename string) (backzoneZones, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	zs := make(backzoneZones)
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || fields[0] != "Zone" {
+			continue
+		}
+		zs[fields[1]] = true
+	}
+
+	return zs, s.Err()
+}
+
+// addBackward adds the links not already in countries, indexed by
+// code in cmap, as zones of the countries of the zone they link to,
+// flagged Deprecated unless a zone of backzone, returning the links
+// Go can't load.
+func addBackward(countries []tz.Country, cmap map[string]int, links backwardLinks, backzone backzoneZones) []skippedZone {
+	owners := make(map[string][]string)
+	for _, c := range countries {
+		for _, z := range c.Zones {
+			owners[z.Name] = append(owners[z.Name], c.Code)
+		}
+	}
+
+	// zones of the data that are themselves links, eg. Europe/Kiev,
+	// stand in for the zone they link to, eg. Europe/Kyiv.
+	equivalents := make(map[string]string)
+	for name := range owners {
+		if target, ok := links[name]; ok {
+			equivalents[target] = name
+		}
+	}
+
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var skipped []skippedZone
+
+	for _, name := range names {
+		if _, ok := owners[name]; ok {
+			continue
+		}
+
+		target := links[name]
+		if _, ok := owners[target]; !ok {
+			target = equivalents[target]
+		}
+
+		codes := owners[target]
+		if len(codes) == 0 {
+			logger.Debug("skipping backward zone linking outside the data", "zone", name, "link", links[name])
+			continue
+		}
+
+		if err := tz.CheckLoadable(name); err != nil {
+			logger.Warn("skipping backward zone not loadable by Go", "zone", name, "err", err)
+			skipped = append(skipped, skippedZone{Zone: name, CountryCode: codes[0], Reason: err.Error()})
+			continue
+		}
+
+		logger.Debug("adding backward zone", "zone", name, "link", target, "backzone", backzone[name])
+
+		for _, code := range codes {
+			c := &countries[cmap[code]]
+			c.Zones = append(c.Zones, tz.Zone{CountryCode: code, Name: name, Deprecated: !backzone[name]})
+		}
+	}
+
+	flagHistorical(countries, links, backzone)
+
+	return skipped
+}
+
+// flagHistorical sets the HistoricalAccuracy of the zones, accurate
+// for those that aren't links, so have their own pre-1970 history, or
+// are zones of backzone, and since 1970 for the others. Zones linking
+// outside the data, eg. Europe/Kiev to Europe/Kyiv, are renames
+// standing in for the zone they link to, so are accurate too.
+func flagHistorical(countries []tz.Country, links backwardLinks, backzone backzoneZones) {
+	names := make(map[string]bool)
+	for _, c := range countries {
+		for _, z := range c.Zones {
+			names[z.Name] = true
+		}
+	}
+
+	for i := range countries {
+		for j := range countries[i].Zones {
+			z := &countries[i].Zones[j]
+			target, link := links[z.Name]
+			if !link || !names[target] || backzone[z.Name] {
+				z.HistoricalAccuracy = tz.HistoricalAccurate
+			} else {
+				z.HistoricalAccuracy = tz.HistoricalSince1970
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import "github.com/go-playground/tz"
+
+// commonZones contains the curated zones flagged as Common, one per
+// commonly selected region, after the zones of the Rails
+// ActiveSupport::TimeZone mapping.
+var commonZones = fieldSet(`
+	Pacific/Midway Pacific/Pago_Pago Pacific/Honolulu America/Juneau
+	America/Los_Angeles America/Tijuana America/Denver America/Phoenix
+	America/Chihuahua America/Mazatlan America/Chicago America/Regina
+	America/Mexico_City America/Monterrey America/Guatemala
+	America/New_York America/Indiana/Indianapolis America/Bogota
+	America/Lima America/Halifax America/Caracas America/La_Paz
+	America/Santiago America/St_Johns America/Sao_Paulo
+	America/Argentina/Buenos_Aires America/Montevideo America/Guyana
+	America/Puerto_Rico America/Nuuk Atlantic/South_Georgia
+	Atlantic/Azores Atlantic/Cape_Verde Europe/Dublin Europe/London
+	Europe/Lisbon Africa/Casablanca Africa/Monrovia Europe/Belgrade
+	Europe/Bratislava Europe/Budapest Europe/Ljubljana Europe/Prague
+	Europe/Sarajevo Europe/Skopje Europe/Warsaw Europe/Zagreb
+	Europe/Brussels Europe/Copenhagen Europe/Madrid Europe/Paris
+	Europe/Amsterdam Europe/Berlin Europe/Zurich Europe/Rome
+	Europe/Stockholm Europe/Vienna Africa/Algiers Europe/Bucharest
+	Africa/Cairo Europe/Helsinki Europe/Kiev Europe/Riga Europe/Sofia
+	Europe/Tallinn Europe/Vilnius Europe/Athens Europe/Istanbul
+	Europe/Minsk Asia/Jerusalem Africa/Harare Africa/Johannesburg
+	Europe/Kaliningrad Europe/Moscow Europe/Volgograd Europe/Samara
+	Asia/Kuwait Asia/Riyadh Africa/Nairobi Asia/Baghdad Asia/Tehran
+	Asia/Muscat Asia/Baku Asia/Tbilisi Asia/Yerevan Asia/Kabul
+	Asia/Yekaterinburg Asia/Karachi Asia/Tashkent Asia/Kolkata
+	Asia/Kathmandu Asia/Dhaka Asia/Colombo Asia/Almaty Asia/Novosibirsk
+	Asia/Yangon Asia/Bangkok Asia/Jakarta Asia/Krasnoyarsk Asia/Shanghai
+	Asia/Hong_Kong Asia/Urumqi Asia/Kuala_Lumpur Asia/Singapore
+	Asia/Taipei Australia/Perth Asia/Irkutsk Asia/Ulaanbaatar Asia/Seoul
+	Asia/Tokyo Asia/Yakutsk Australia/Darwin Australia/Adelaide
+	Australia/Melbourne Australia/Sydney Australia/Brisbane
+	Australia/Hobart Asia/Vladivostok Pacific/Guam Pacific/Port_Moresby
+	Asia/Magadan Asia/Srednekolymsk Pacific/Guadalcanal Pacific/Noumea
+	Pacific/Fiji Asia/Kamchatka Pacific/Majuro Pacific/Auckland
+	Pacific/Tongatapu Pacific/Fakaofo Pacific/Chatham Pacific/Apia
+`)
+
+// flagCommon sets Common on the zones found in commonZones,
+// logging the curated zones that are missing from the data.
+func flagCommon(countries []tz.Country) {
+	found := make(map[string]bool)
+
+	for i := range countries {
+		for j := range countries[i].Zones {
+			z := &countries[i].Zones[j]
+			if commonZones[z.Name] {
+				z.Common = true
+				found[z.Name] = true
+			}
+		}
+	}
+
+	for name := range commonZones {
+		if !found[name] {
+			logger.Warn("common zone not found in data", "zone", name)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import "github.com/go-playground/tz"
+
+// logReport logs the countries and zones added, removed
+// or renamed in r, as returned by tz.Diff.
+func logReport(r tz.Report) {
+	for _, c := range r.AddedCountries {
+		logger.Info("country added", "code", c.Code, "name", c.Name)
+	}
+	for _, c := range r.RemovedCountries {
+		logger.Info("country removed", "code", c.Code, "name", c.Name)
+	}
+	for _, c := range r.RenamedCountries {
+		logger.Info("country renamed", "code", c.Code, "from", c.From, "to", c.To)
+	}
+
+	for _, z := range r.AddedZones {
+		logger.Info("zone added", "country", z.CountryCode, "zone", z.Name)
+	}
+	for _, z := range r.RemovedZones {
+		logger.Info("zone removed", "country", z.CountryCode, "zone", z.Name)
+	}
+	for _, z := range r.RenamedZones {
+		logger.Info("zone renamed", "country", z.CountryCode, "from", z.From, "to", z.To)
+	}
+
+	changes := len(r.AddedCountries) + len(r.RemovedCountries) + len(r.RenamedCountries) +
+		len(r.AddedZones) + len(r.RemovedZones) + len(r.RenamedZones)
+
+	logger.Info("data changes", "count", changes)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/go-playground/tz"
+)
+
+// Files written alongside the Go output when embedding,
+// the literal output then only being built with the
+// tz_literal build tag.
+const (
+	embedDataFile = "tz_data.json"
+	embedGoFile   = "tz_embed.go"
+)
+
+var embedOutput = `//go:build !tz_literal
+
+package {{ .Package }}
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// GENERATED FILE DO NOT MODIFY DIRECTLY
+
+{{ template "consts" . }}
+
+//go:embed ` + embedDataFile + `
+var encodedCountries []byte
+
+// loadData decodes the countries embedded in the binary.
+func loadData() ([]Country, map[string]Country) {
+	var cs []Country
+	if err := json.Unmarshal(encodedCountries, &cs); err != nil {
+		panic("tz: decoding embedded data: " + err.Error())
+	}
+
+	// empty slices are omitted from the embedded data.
+	for i := range cs {
+		if cs[i].Synonyms == nil {
+			cs[i].Synonyms = []string{}
+		}
+		if cs[i].Zones == nil {
+			cs[i].Zones = []Zone{}
+		}
+	}
+	return cs, nil
+}
+`
+
+var embedEmitter = templateEmitter{
+	tmpl:  template.Must(template.New("embed").Parse(consts + embedOutput)),
+	ext:   ".go",
+	gofmt: true,
+}
+
+// embeddedZone and embeddedCountry mirror tz.Zone and tz.Country,
+// omitting their zero values from the embedded data, which decode
+// back to the same when left out.
+type embeddedZone struct {
+	CountryCode        string                `json:",omitempty"`
+	Name               string                `json:",omitempty"`
+	Common             bool                  `json:",omitempty"`
+	Deprecated         bool                  `json:",omitempty"`
+	HistoricalAccuracy tz.HistoricalAccuracy `json:",omitempty"`
+}
+
+type embeddedCountry struct {
+	Code         string         `json:",omitempty"`
+	Name         string         `json:",omitempty"`
+	Ordinal      int            `json:",omitempty"`
+	Synonyms     []string       `json:",omitempty"`
+	UserAssigned bool           `json:",omitempty"`
+	Zones        []embeddedZone `json:",omitempty"`
+}
+
+// embeddedJSON returns the countries as compact JSON,
+// without their zero valued fields.
+func embeddedJSON(countries []tz.Country) ([]byte, error) {
+	ecs := make([]embeddedCountry, len(countries))
+	for i, c := range countries {
+		ecs[i] = embeddedCountry{
+			Code:         c.Code,
+			Name:         c.Name,
+			Ordinal:      c.Ordinal,
+			Synonyms:     c.Synonyms,
+			UserAssigned: c.UserAssigned,
+		}
+		for _, z := range c.Zones {
+			ecs[i].Zones = append(ecs[i].Zones, embeddedZone(z))
+		}
+	}
+	return json.Marshal(ecs)
+}
+
+// writeEmbedded writes the countries as compact JSON, along with its
+// manifest, and the Go file embedding and decoding them to dir.
+func writeEmbedded(dir string, data templateData) error {
+	b, err := embeddedJSON(data.Countries)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(dir, embedDataFile)
+
+	if err = os.WriteFile(filename, b, 0644); err != nil {
+		return err
+	}
+
+	if err = writeManifest(filename, b); err != nil {
+		return err
+	}
+
+	src, err := embedEmitter.Emit(data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, embedGoFile), src, 0644)
+}
+
+// removeEmbedded removes the files written by writeEmbedded from
+// dir, which would otherwise clash with untagged literal output.
+func removeEmbedded(dir string) error {
+	for _, name := range []string{embedDataFile, embedDataFile + manifestExt, embedGoFile} {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Emitter renders the processed data into a single output file.
+type Emitter interface {
+	// Ext returns the file extension of the output,
+	// used to name the default output file.
+	Ext() string
+
+	// Emit returns the contents of the output file for data.
+	Emit(data templateData) ([]byte, error)
+}
+
+// emitters contains the built-in Emitters selectable with -format.
+var emitters = map[string]Emitter{
+	"go": templateEmitter{
+		tmpl:  template.Must(template.New("go").Parse(consts + output)),
+		ext:   ".go",
+		gofmt: true,
+	},
+	"go-map": templateEmitter{
+		tmpl:       template.Must(template.New("go").Parse(consts + output)),
+		ext:        ".go",
+		gofmt:      true,
+		mapLiteral: true,
+	},
+	"json": jsonEmitter{},
+	"sql":  sqlEmitter{},
+	"ts":   tsEmitter{},
+	"csv":  csvEmitter{},
+	"py":   seedEmitter{ext: ".py", syntax: pythonSyntax},
+	"rb":   seedEmitter{ext: ".rb", syntax: rubySyntax},
+	"php":  seedEmitter{ext: ".php", syntax: phpSyntax},
+}
+
+// formats returns the sorted names of the built-in Emitters.
+func formats() []string {
+	names := make([]string, 0, len(emitters))
+	for name := range emitters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// templateEmitter executes a text/template with the data,
+// optionally formatting the result with gofmt -s.
+// When mapLiteral is set the data is emitted as a map literal
+// keyed by country code rather than a slice indexed at init.
+type templateEmitter struct {
+	tmpl       *template.Template
+	ext        string
+	gofmt      bool
+	mapLiteral bool
+}
+
+func (e templateEmitter) Ext() string {
+	return e.ext
+}
+
+func (e templateEmitter) Emit(data templateData) ([]byte, error) {
+	data.MapLiteral = e.mapLiteral
+
+	var buff bytes.Buffer
+	if err := e.tmpl.Execute(&buff, data); err != nil {
+		return nil, err
+	}
+
+	if !e.gofmt {
+		return buff.Bytes(), nil
+	}
+
+	var out, stderr bytes.Buffer
+	cmd := exec.Command("gofmt", "-s")
+	cmd.Stdin = &buff
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gofmt: %w: %s", err, stderr.String())
+	}
+
+	return out.Bytes(), nil
+}
+
+// jsonEmitter emits the countries as a JSON array, as
+// described by the JSON Schema written alongside it.
+type jsonEmitter struct{}
+
+func (jsonEmitter) Ext() string {
+	return ".json"
+}
+
+func (jsonEmitter) Emit(data templateData) ([]byte, error) {
+	b, err := json.MarshalIndent(data.Countries, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// tsEmitter emits a TypeScript module exporting the
+// Country and Zone interfaces and the countries.
+type tsEmitter struct{}
+
+func (tsEmitter) Ext() string {
+	return ".ts"
+}
+
+func (tsEmitter) Emit(data templateData) ([]byte, error) {
+	b, err := json.MarshalIndent(data.Countries, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buff bytes.Buffer
+	buff.WriteString("// GENERATED FILE DO NOT MODIFY DIRECTLY\n\n")
+	buff.WriteString("export interface Zone {\n  CountryCode: string;\n  Name: string;\n  Common: boolean;\n  Deprecated: boolean;\n  HistoricalAccuracy: \"\" | \"accurate\" | \"since-1970\";\n}\n\n")
+	buff.WriteString("export interface Country {\n  Code: string;\n  Name: string;\n  Ordinal: number;\n  Synonyms: string[];\n  UserAssigned: boolean;\n  Zones: Zone[];\n}\n\n")
+	buff.WriteString("export const countries: readonly Country[] = ")
+	buff.Write(b)
+	buff.WriteString(";\n")
+
+	return buff.Bytes(), nil
+}
+
+// csvEmitter emits one country_code,country_name,zone row per zone,
+// countries without zones having a single row with an empty zone.
+type csvEmitter struct{}
+
+func (csvEmitter) Ext() string {
+	return ".csv"
+}
+
+func (csvEmitter) Emit(data templateData) ([]byte, error) {
+	var buff bytes.Buffer
+	w := csv.NewWriter(&buff)
+
+	if err := w.Write([]string{"country_code", "country_name", "zone"}); err != nil {
+		return nil, err
+	}
+
+	for _, c := range data.Countries {
+		if len(c.Zones) == 0 {
+			if err := w.Write([]string{c.Code, c.Name, ""}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, z := range c.Zones {
+			if err := w.Write([]string{c.Code, c.Name, z.Name}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	return buff.Bytes(), w.Error()
+}
+
+// sqlEmitter emits ANSI SQL creating and populating
+// countries and zones tables.
+type sqlEmitter struct{}
+
+func (sqlEmitter) Ext() string {
+	return ".sql"
+}
+
+func (sqlEmitter) Emit(data templateData) ([]byte, error) {
+	var buff bytes.Buffer
+
+	buff.WriteString("-- GENERATED FILE DO NOT MODIFY DIRECTLY\n\n")
+	buff.WriteString("CREATE TABLE countries (\n  code CHAR(2) PRIMARY KEY,\n  name VARCHAR(255) NOT NULL\n);\n\n")
+	buff.WriteString("CREATE TABLE zones (\n  name VARCHAR(64) NOT NULL,\n  country_code CHAR(2) NOT NULL REFERENCES countries (code),\n  PRIMARY KEY (name, country_code)\n);\n\n")
+
+	for _, c := range data.Countries {
+		fmt.Fprintf(&buff, "INSERT INTO countries (code, name) VALUES (%s, %s);\n", sqlQuote(c.Code), sqlQuote(c.Name))
+	}
+
+	buff.WriteString("\n")
+
+	for _, c := range data.Countries {
+		for _, z := range c.Zones {
+			fmt.Fprintf(&buff, "INSERT INTO zones (name, country_code) VALUES (%s, %s);\n", sqlQuote(z.Name), sqlQuote(z.CountryCode))
+		}
+	}
+
+	return buff.Bytes(), nil
+}
+
+// sqlQuote returns s as a single quoted SQL string literal.
+func sqlQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// seedSyntax describes the literals of a language
+// a seedEmitter emits the countries in.
+type seedSyntax struct {
+	header, footer      string
+	mapOpen, mapClose   string
+	listOpen, listClose string
+	sep                 string
+	trueLit, falseLit   string
+	quote               func(string) string
+}
+
+var (
+	pythonSyntax = seedSyntax{
+		header:    "# GENERATED FILE DO NOT MODIFY DIRECTLY\n\nCOUNTRIES = {\n",
+		footer:    "}\n",
+		mapOpen:   "{",
+		mapClose:  "}",
+		listOpen:  "[",
+		listClose: "]",
+		sep:       ": ",
+		trueLit:   "True",
+		falseLit:  "False",
+		quote:     strconv.Quote,
+	}
+	rubySyntax = seedSyntax{
+		header:    "# frozen_string_literal: true\n\n# GENERATED FILE DO NOT MODIFY DIRECTLY\n\nCOUNTRIES = {\n",
+		footer:    "}.freeze\n",
+		mapOpen:   "{",
+		mapClose:  "}.freeze",
+		listOpen:  "[",
+		listClose: "].freeze",
+		sep:       " => ",
+		trueLit:   "true",
+		falseLit:  "false",
+		quote: func(s string) string {
+			return strings.ReplaceAll(strconv.Quote(s), "#", `\#`)
+		},
+	}
+	phpSyntax = seedSyntax{
+		header:    "<?php\n\n// GENERATED FILE DO NOT MODIFY DIRECTLY\n\nreturn [\n",
+		footer:    "];\n",
+		mapOpen:   "[",
+		mapClose:  "]",
+		listOpen:  "[",
+		listClose: "]",
+		sep:       " => ",
+		trueLit:   "true",
+		falseLit:  "false",
+		quote: func(s string) string {
+			return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+		},
+	}
+)
+
+// seedEmitter emits a seed module of a dynamic language, a map of
+// country codes to the countries, per its syntax, eg. a Python dict,
+// a frozen Ruby hash or a PHP array.
+type seedEmitter struct {
+	ext    string
+	syntax seedSyntax
+}
+
+func (e seedEmitter) Ext() string {
+	return e.ext
+}
+
+func (e seedEmitter) Emit(data templateData) ([]byte, error) {
+	x := e.syntax
+	boolLit := func(b bool) string {
+		if b {
+			return x.trueLit
+		}
+		return x.falseLit
+	}
+	field := func(key, value string) string {
+		return x.quote(key) + x.sep + value
+	}
+
+	var buff bytes.Buffer
+	buff.WriteString(x.header)
+
+	for _, c := range data.Countries {
+		fmt.Fprintf(&buff, "    %s%s%s\n", x.quote(c.Code), x.sep, x.mapOpen)
+		fmt.Fprintf(&buff, "        %s,\n", field("code", x.quote(c.Code)))
+		fmt.Fprintf(&buff, "        %s,\n", field("name", x.quote(c.Name)))
+		fmt.Fprintf(&buff, "        %s,\n", field("ordinal", strconv.Itoa(c.Ordinal)))
+		fmt.Fprintf(&buff, "        %s,\n", field("synonyms", x.listOpen+quoteAll(c.Synonyms, x.quote)+x.listClose))
+		fmt.Fprintf(&buff, "        %s,\n", field("user_assigned", boolLit(c.UserAssigned)))
+		fmt.Fprintf(&buff, "        %s%s%s\n", x.quote("zones"), x.sep, x.listOpen)
+
+		for _, z := range c.Zones {
+			fmt.Fprintf(&buff, "            %s%s, %s, %s, %s, %s%s,\n", x.mapOpen,
+				field("country_code", x.quote(z.CountryCode)),
+				field("name", x.quote(z.Name)),
+				field("common", boolLit(z.Common)),
+				field("deprecated", boolLit(z.Deprecated)),
+				field("historical_accuracy", x.quote(string(z.HistoricalAccuracy))),
+				x.mapClose)
+		}
+
+		fmt.Fprintf(&buff, "        %s,\n", x.listClose)
+		fmt.Fprintf(&buff, "    %s,\n", x.mapClose)
+	}
+
+	buff.WriteString(x.footer)
+
+	return buff.Bytes(), nil
+}
+
+// quoteAll returns ss quoted by quote, comma separated.
+func quoteAll(ss []string, quote func(string) string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = quote(s)
+	}
+	return strings.Join(quoted, ", ")
+}
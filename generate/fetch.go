@@ -0,0 +1,203 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// errNotModified is returned by a Fetcher when the file found at url
+// is unchanged since it was retrieved with the passed Validators.
+var errNotModified = errors.New("not modified")
+
+// Validators identify a single version of a retrieved file.
+type Validators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// IsZero returns whether no validators are set.
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// Archive is a retrieved database file.
+type Archive struct {
+	Data       []byte
+	Validators Validators
+
+	// Downloaded is when the file was downloaded,
+	// earlier than it was retrieved when cached.
+	Downloaded time.Time
+}
+
+// Fetcher retrieves the database file found at url.
+// When prev is set and the file is unchanged since, errNotModified
+// may be returned instead.
+type Fetcher interface {
+	Fetch(url string, prev Validators) (Archive, error)
+}
+
+// httpFetcher downloads the database file, honouring
+// the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables of http.DefaultClient.
+type httpFetcher struct {
+	client *http.Client
+}
+
+func (h httpFetcher) Fetch(url string, prev Validators) (Archive, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Archive{}, err
+	}
+	if prev.ETag != "" {
+		req.Header.Set("If-None-Match", prev.ETag)
+	}
+	if prev.LastModified != "" {
+		req.Header.Set("If-Modified-Since", prev.LastModified)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return Archive{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return Archive{Validators: prev}, errNotModified
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Archive{}, fmt.Errorf("response status is: %s", resp.Status)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Archive{}, err
+	}
+
+	return Archive{
+		Data: b,
+		Validators: Validators{
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		},
+		Downloaded: time.Now().UTC(),
+	}, nil
+}
+
+// cacheFetcher keeps the files retrieved by next in dir so
+// repeated runs don't download them again while unchanged.
+// Files are keyed by a checksum of their url and of their
+// contents, an index per url recording the current file along
+// with its validators, and verified on every read.
+// Cached files are revalidated with next on every run, sending
+// their validators, and only served when next reports them
+// not modified.
+type cacheFetcher struct {
+	dir  string
+	next Fetcher
+}
+
+// cacheEntry is the index of the file cached for a url.
+type cacheEntry struct {
+	URL        string     `json:"url"`
+	Checksum   string     `json:"checksum"`
+	Validators Validators `json:"validators"`
+	Downloaded time.Time  `json:"downloaded"`
+}
+
+func (c cacheFetcher) Fetch(url string, prev Validators) (Archive, error) {
+	key := checksum([]byte(url))
+	indexFile := filepath.Join(c.dir, key+".json")
+
+	var (
+		entry  cacheEntry
+		cached Archive
+		found  bool
+	)
+
+	if err := readJSON(indexFile, &entry); err == nil && entry.URL == url && !entry.Validators.IsZero() {
+		b, err := os.ReadFile(c.dataFile(key, entry.Checksum))
+		if err == nil && checksum(b) == entry.Checksum {
+			cached = Archive{Data: b, Validators: entry.Validators, Downloaded: entry.Downloaded}
+			found = true
+		}
+	}
+
+	validators := prev
+	if found {
+		validators = cached.Validators
+	}
+
+	a, err := c.next.Fetch(url, validators)
+	if err == errNotModified {
+		if !found || (!prev.IsZero() && cached.Validators == prev) {
+			return Archive{Validators: prev}, errNotModified
+		}
+		return cached, nil
+	}
+	if err != nil {
+		return a, err
+	}
+
+	if err = os.MkdirAll(c.dir, 0755); err != nil {
+		return a, err
+	}
+	sum := checksum(a.Data)
+	if err = os.WriteFile(c.dataFile(key, sum), a.Data, 0644); err != nil {
+		return a, err
+	}
+	err = writeJSON(indexFile, cacheEntry{
+		URL:        url,
+		Checksum:   sum,
+		Validators: a.Validators,
+		Downloaded: a.Downloaded,
+	})
+	if err != nil {
+		return a, err
+	}
+
+	// the previous file of url is superseded.
+	if entry.Checksum != "" && entry.Checksum != sum {
+		os.Remove(c.dataFile(key, entry.Checksum))
+	}
+
+	return a, nil
+}
+
+// dataFile returns the path of the cached file of the url
+// and contents checksums passed.
+func (c cacheFetcher) dataFile(key, sum string) string {
+	return filepath.Join(c.dir, key+"-"+sum+".zip")
+}
+
+// checksum returns the hex encoded SHA-256 of b.
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// readJSON decodes the JSON file filename into v.
+func readJSON(filename string, v interface{}) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// writeJSON encodes v into the JSON file filename.
+func writeJSON(filename string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(b, '\n'), 0644)
+}
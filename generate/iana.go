@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/tz"
+)
+
+// ianaZones maps zone names to the codes of the countries they're
+// used in, as read from an IANA zone.tab or zone1970.tab file.
+type ianaZones map[string][]string
+
+// readZoneTab reads the zones of the IANA zone.tab or
+// zone1970.tab file filename.
+func readZoneTab(filename string) (ianaZones, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	zs := make(ianaZones)
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("invalid zone table line %q", line)
+		}
+
+		zs[fields[2]] = strings.Split(fields[0], ",")
+	}
+
+	return zs, s.Err()
+}
+
+// Kinds of conflict between the merged sources.
+const (
+	conflictOnlyTimezoneDB = "only-timezonedb"
+	conflictOnlyIANA       = "only-iana"
+	conflictCountries      = "countries"
+)
+
+// conflict is a disagreement between the merged sources on a zone
+// and how it was resolved.
+type conflict struct {
+	Zone       string   `json:"zone"`
+	Kind       string   `json:"kind"`
+	TimezoneDB []string `json:"timezonedb"`
+	IANA       []string `json:"iana"`
+	Resolution string   `json:"resolution"`
+}
+
+// mergeIANA merges the zones of iana into countries, indexed by code
+// in cmap, returning the conflicts between the sources. Zones found
+// in only one of the sources are kept, zones whose countries differ
+// between them take the countries of the source given precedence
+// with -precedence.
+func mergeIANA(countries []tz.Country, cmap map[string]int, iana ianaZones) ([]skippedZone, []conflict) {
+	tzdb := make(map[string][]string)
+	for _, c := range countries {
+		for _, z := range c.Zones {
+			tzdb[z.Name] = append(tzdb[z.Name], c.Code)
+		}
+	}
+
+	names := make([]string, 0, len(iana))
+	for name := range iana {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		skipped   []skippedZone
+		conflicts []conflict
+	)
+
+	for name, codes := range tzdb {
+		if _, ok := iana[name]; !ok {
+			conflicts = append(conflicts, conflict{Zone: name, Kind: conflictOnlyTimezoneDB, TimezoneDB: codes, Resolution: "kept"})
+		}
+	}
+
+	for _, name := range names {
+		codes := iana[name]
+
+		current, ok := tzdb[name]
+		if ok && sameCodes(current, codes) {
+			continue
+		}
+
+		if ok && *precedence == "timezonedb" {
+			conflicts = append(conflicts, conflict{Zone: name, Kind: conflictCountries, TimezoneDB: current, IANA: codes, Resolution: "kept timezonedb countries"})
+			continue
+		}
+
+		if !ok {
+			if err := tz.CheckLoadable(name); err != nil {
+				logger.Warn("skipping IANA zone not loadable by Go", "zone", name, "err", err)
+				skipped = append(skipped, skippedZone{Zone: name, CountryCode: codes[0], Reason: err.Error()})
+				conflicts = append(conflicts, conflict{Zone: name, Kind: conflictOnlyIANA, IANA: codes, Resolution: "skipped, not loadable by Go"})
+				continue
+			}
+			conflicts = append(conflicts, conflict{Zone: name, Kind: conflictOnlyIANA, IANA: codes, Resolution: "added"})
+		} else {
+			conflicts = append(conflicts, conflict{Zone: name, Kind: conflictCountries, TimezoneDB: current, IANA: codes, Resolution: "took iana countries"})
+		}
+
+		for _, code := range current {
+			c := &countries[cmap[code]]
+			for i := range c.Zones {
+				if c.Zones[i].Name == name {
+					c.Zones = append(c.Zones[:i], c.Zones[i+1:]...)
+					break
+				}
+			}
+		}
+
+		for _, code := range codes {
+			idx, ok := cmap[code]
+			if !ok {
+				logger.Warn("skipping IANA zone of unknown country", "zone", name, "country", code)
+				skipped = append(skipped, skippedZone{Zone: name, CountryCode: code, Reason: "unknown country"})
+				continue
+			}
+
+			logger.Debug("adding IANA zone", "zone", name, "country", code)
+
+			countries[idx].Zones = append(countries[idx].Zones, tz.Zone{CountryCode: code, Name: name})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Zone < conflicts[j].Zone
+	})
+
+	return skipped, conflicts
+}
+
+// sameCodes returns whether a and b contain the same country codes.
+func sameCodes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	seen := make(map[string]bool, len(a))
+	for _, code := range a {
+		seen[code] = true
+	}
+	for _, code := range b {
+		if !seen[code] {
+			return false
+		}
+	}
+	return true
+}
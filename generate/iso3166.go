@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/tz"
+)
+
+// isoCodes contains the officially assigned ISO 3166-1 alpha-2 codes.
+// see https://www.iso.org/iso-3166-country-codes.html
+var isoCodes = fieldSet(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI
+	BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN
+	CO CR CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK
+	FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM
+	HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN
+	KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK
+	ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP
+	NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW
+	SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF
+	TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI
+	VN VU WF WS YE YT ZA ZM ZW
+`)
+
+// retiredCodes contains the formerly assigned ISO 3166-1 alpha-2
+// codes that are now transitionally reserved.
+var retiredCodes = map[string]string{
+	"AN": "Netherlands Antilles",
+	"BU": "Burma",
+	"CS": "Serbia and Montenegro",
+	"DD": "German Democratic Republic",
+	"FX": "France, Metropolitan",
+	"NT": "Neutral Zone",
+	"SU": "USSR",
+	"TP": "East Timor",
+	"YU": "Yugoslavia",
+	"ZR": "Zaire",
+}
+
+// userAssignedCountries contains the countries using user-assigned
+// codes that are widely used in place of an official code, and are
+// added when including user-assigned codes.
+var userAssignedCountries = []tz.Country{
+	{
+		Code: "XK",
+		Name: "Kosovo",
+		Zones: []tz.Zone{
+			{CountryCode: "XK", Name: "Europe/Belgrade"},
+		},
+	},
+}
+
+// isUserAssigned returns whether code is in one of the ISO 3166-1
+// alpha-2 ranges reserved for user assignment; AA, QM to QZ,
+// XA to XZ and ZZ.
+func isUserAssigned(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	switch {
+	case code == "AA", code == "ZZ":
+		return true
+	case code[0] == 'Q':
+		return code[1] >= 'M' && code[1] <= 'Z'
+	case code[0] == 'X':
+		return code[1] >= 'A' && code[1] <= 'Z'
+	}
+	return false
+}
+
+// applyUserAssigned flags the countries using user-assigned codes and,
+// when include is set, adds the missing userAssignedCountries or,
+// when not, removes them all.
+func applyUserAssigned(countries []tz.Country, include bool) []tz.Country {
+	found := make(map[string]bool)
+	result := countries[:0]
+
+	for _, c := range countries {
+		if isUserAssigned(c.Code) {
+			if !include {
+				logger.Info("skipping country with user-assigned code", "code", c.Code, "name", c.Name)
+				continue
+			}
+			c.UserAssigned = true
+			found[c.Code] = true
+		}
+		result = append(result, c)
+	}
+
+	if !include {
+		return result
+	}
+
+	for _, c := range userAssignedCountries {
+		if found[c.Code] {
+			continue
+		}
+		c.UserAssigned = true
+		result = append(result, c)
+	}
+
+	return result
+}
+
+// fieldSet returns the set of the whitespace separated fields in s.
+func fieldSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, code := range strings.Fields(s) {
+		set[code] = true
+	}
+	return set
+}
+
+// validateCodes returns an error for each country whose code is
+// not an officially assigned ISO 3166-1 alpha-2 code.
+func validateCodes(countries []tz.Country) []error {
+	var errs []error
+
+	for _, c := range countries {
+		if isoCodes[c.Code] || c.UserAssigned {
+			continue
+		}
+
+		if name, ok := retiredCodes[c.Code]; ok {
+			errs = append(errs, fmt.Errorf("country %s %q uses the retired ISO 3166-1 code of %s", c.Code, c.Name, name))
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("country %s %q is not an ISO 3166-1 code", c.Code, c.Name))
+	}
+
+	return errs
+}
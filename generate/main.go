@@ -4,13 +4,15 @@ import (
 	"archive/zip"
 	"bytes"
 	"encoding/csv"
-	"fmt"
+	"encoding/json"
+	"flag"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"sort"
+	"strings"
 	"text/template"
 	"time"
 
@@ -22,7 +24,10 @@ const (
 	dbURL       = "https://timezonedb.com/files/" + dbFilename
 	countryFile = "country.csv"
 	zoneFile    = "zone.csv"
-	outputFile  = "../tz_data.go"
+
+	dataSource      = "https://timezonedb.com"
+	dataLicense     = "CC BY 3.0 https://creativecommons.org/licenses/by/3.0/"
+	dataAttribution = "Timezone data provided by TimeZoneDB (https://timezonedb.com), licensed under CC BY 3.0."
 )
 
 type countryColumn int
@@ -44,9 +49,20 @@ const (
 
 type byCountryName []tz.Country
 
-func (a byCountryName) Len() int           { return len(a) }
-func (a byCountryName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a byCountryName) Less(i, j int) bool { return a[i].Name < a[j].Name }
+func (a byCountryName) Len() int      { return len(a) }
+func (a byCountryName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byCountryName) Less(i, j int) bool {
+	if a[i].Name != a[j].Name {
+		return a[i].Name < a[j].Name
+	}
+	return a[i].Code < a[j].Code
+}
+
+type byCountryCode []tz.Country
+
+func (a byCountryCode) Len() int           { return len(a) }
+func (a byCountryCode) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byCountryCode) Less(i, j int) bool { return a[i].Code < a[j].Code }
 
 type byZoneName []tz.Zone
 
@@ -54,34 +70,246 @@ func (a byZoneName) Len() int           { return len(a) }
 func (a byZoneName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a byZoneName) Less(i, j int) bool { return a[i].Name < a[j].Name }
 
-func main() {
-	tmpl, err := template.New("gen").Parse(output)
+// byZoneOffset sorts zones west to east by their standard offset,
+// then alphabetically.
+type byZoneOffset []tz.Zone
+
+func (a byZoneOffset) Len() int      { return len(a) }
+func (a byZoneOffset) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byZoneOffset) Less(i, j int) bool {
+	oi, oj := standardOffset(a[i].Name), standardOffset(a[j].Name)
+	if oi != oj {
+		return oi < oj
+	}
+	return a[i].Name < a[j].Name
+}
+
+// standardOffset returns the standard, non daylight saving, offset
+// in seconds east of UTC of the zone this year; the lesser of
+// its January and July offsets.
+func standardOffset(zone string) int {
+	loc, err := time.LoadLocation(zone)
 	if err != nil {
-		log.Fatal("ERROR parsing template:", err)
+		return 0
+	}
+
+	year := time.Now().Year()
+	_, jan := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).In(loc).Zone()
+	_, jul := time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC).In(loc).Zone()
+
+	if jan < jul {
+		return jan
+	}
+	return jul
+}
+
+// exitNotModified is the exit status when the database file
+// is unchanged since the last run and nothing was generated.
+const exitNotModified = 3
+
+// sourceState records the version of the database file
+// the current data was generated from, and the output
+// generated.
+type sourceState struct {
+	URL        string     `json:"url"`
+	Validators Validators `json:"validators"`
+	Output     string     `json:"output"`
+	Format     string     `json:"format"`
+	Template   string     `json:"template,omitempty"`
+}
+
+var (
+	sourceURL     = flag.String("url", dbURL, "URL of the timezonedb.com csv database archive, eg. a mirror")
+	cacheDir      = flag.String("cache", "", "directory to cache the downloaded database archive in")
+	stateFile     = flag.String("state", "source.json", "file recording the ETag/Last-Modified of the database archive last generated from")
+	force         = flag.Bool("force", false, "regenerate even if the database archive is unchanged since the last run")
+	verbose       = flag.Bool("v", false, "verbose, log debug output")
+	quiet         = flag.Bool("q", false, "quiet, only log errors")
+	jsonLog       = flag.Bool("json", false, "log as JSON rather than text")
+	outputFile    = flag.String("o", "", "file to write the generated code to, - for stdout (default ../tz_data with the extension of the format)")
+	userAssigned  = flag.Bool("user-assigned", false, "include countries with user-assigned codes, eg. XK Kosovo")
+	isoCheck      = flag.String("iso-check", "fail", "validation of country codes against ISO 3166-1, fail, warn or off")
+	ordinalsFile  = flag.String("ordinals", "ordinals.csv", "file persisting the stable country ordinals across runs")
+	sortCountries = flag.String("sort-countries", "name", "order of the countries, name (English name, then code) or code (ISO code)")
+	sortZones     = flag.String("sort-zones", "name", "order of the zones within a country, name or offset (standard offset west to east, then name)")
+	format        = flag.String("format", "go", "output format, one of go, go-map, json, sql, ts, csv, py, rb or php")
+	pkgName       = flag.String("pkg", "tz", "package name of the generated code")
+	templateFile  = flag.String("template", "", "text/template file to generate the output with instead of the built-in Go template")
+	dryRun        = flag.Bool("dry-run", false, "report what would change without writing anything")
+	stripNames    = flag.Bool("strip-names", false, "omit the country names, the Go package returning the code as Name, for size-sensitive targets")
+	ianaFile      = flag.String("iana", "", "IANA zone.tab (or zone1970.tab) file to merge the zones of into the timezonedb.com data")
+	precedence    = flag.String("precedence", "timezonedb", "source whose countries a zone takes when the merged sources disagree, timezonedb or iana")
+	conflictsFile = flag.String("conflicts", "", "file to write a JSON report of the conflicts between the merged sources to, - for stdout")
+	failConflicts = flag.Bool("fail-on-conflict", false, "fail when the merged sources conflict")
+	backwardFile  = flag.String("backward", "", "IANA backward (or tzdata.zi) file whose links to include as zones flagged Deprecated, eg. US/Eastern")
+	backzoneFile  = flag.String("backzone", "", "IANA backzone file whose zones to include as distinct zones, requires -backward")
+	aliasesFile   = flag.String("aliases", "", "IANA backward (or tzdata.zi) file whose links to write as the zone aliases, defaults to the -backward file")
+	embed         = flag.Bool("embed", true, "embed the data as JSON decoded on first use, the Go literal output only being built with the tz_literal build tag")
+	summary       = flag.String("summary", "", "file to write a JSON summary of the generated and skipped data to, - for stdout")
+)
+
+// logger is the generator's structured logger, configured by the -v, -q and -json flags.
+var logger = slog.Default()
+
+// skippedZone is a zone row from the database file
+// that was left out of the generated data.
+type skippedZone struct {
+	Zone        string `json:"zone"`
+	CountryCode string `json:"country_code"`
+	Reason      string `json:"reason"`
+}
+
+// runSummary is the machine-readable summary of a generator run.
+type runSummary struct {
+	Countries int           `json:"countries"`
+	Zones     int           `json:"zones"`
+	Skipped   []skippedZone `json:"skipped"`
+}
+
+func init() {
+	flag.StringVar(outputFile, "out", *outputFile, "alias of -o")
+}
+
+// templateData is the context the output template is executed with.
+type templateData struct {
+	Package     string
+	MapLiteral  bool
+	Embed       bool
+	Source      string
+	License     string
+	Attribution string
+	Provenance  provenance
+	Countries   []tz.Country
+	Aliases     map[string]string
+}
+
+func main() {
+	flag.Parse()
+
+	level := slog.LevelInfo
+	switch {
+	case *quiet:
+		level = slog.LevelError
+	case *verbose:
+		level = slog.LevelDebug
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	if *jsonLog {
+		logger = slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	} else {
+		logger = slog.New(slog.NewTextHandler(os.Stderr, opts))
+	}
+
+	emitter, ok := emitters[*format]
+	if !ok {
+		fatal("unknown format "+*format+", must be one of "+strings.Join(formats(), ", "), nil)
+	}
+
+	if *isoCheck != "fail" && *isoCheck != "warn" && *isoCheck != "off" {
+		fatal("unknown ISO check mode "+*isoCheck+", must be fail, warn or off", nil)
+	}
+
+	if *sortCountries != "name" && *sortCountries != "code" {
+		fatal("unknown country sort order "+*sortCountries+", must be name or code", nil)
+	}
+
+	if *sortZones != "name" && *sortZones != "offset" {
+		fatal("unknown zone sort order "+*sortZones+", must be name or offset", nil)
+	}
+
+	if *precedence != "timezonedb" && *precedence != "iana" {
+		fatal("unknown precedence "+*precedence+", must be timezonedb or iana", nil)
+	}
+
+	var iana ianaZones
+	if *ianaFile != "" {
+		var err error
+		if iana, err = readZoneTab(*ianaFile); err != nil {
+			fatal("reading IANA zone table", err)
+		}
+	}
+
+	var links backwardLinks
+	if *backwardFile != "" {
+		var err error
+		if links, err = readBackward(*backwardFile); err != nil {
+			fatal("reading IANA backward file", err)
+		}
+	}
+
+	aliasLinks := links
+	if *aliasesFile != "" {
+		var err error
+		if aliasLinks, err = readBackward(*aliasesFile); err != nil {
+			fatal("reading IANA aliases file", err)
+		}
+	}
+
+	var backzone backzoneZones
+	if *backzoneFile != "" {
+		if links == nil {
+			fatal("-backzone requires -backward, linking its zones to their countries", nil)
+		}
+
+		var err error
+		if backzone, err = readBackzone(*backzoneFile); err != nil {
+			fatal("reading IANA backzone file", err)
+		}
+	}
+
+	if *templateFile != "" {
+		tmpl, err := template.ParseFiles(*templateFile)
+		if err != nil {
+			fatal("parsing template", err)
+		}
+
+		// only Go output is run through gofmt
+		emitter = templateEmitter{
+			tmpl:  tmpl,
+			ext:   ".go",
+			gofmt: *outputFile == "" || filepath.Ext(*outputFile) == ".go",
+		}
+	}
+
+	if *outputFile == "" {
+		*outputFile = "../tz_data" + emitter.Ext()
 	}
 
 	cwd, err := os.Getwd()
 	if err != nil {
-		log.Fatal("ERROR determining current working DIR:", err)
+		fatal("determining current working DIR", err)
 	}
 
-	resp, err := http.DefaultClient.Get(dbURL)
-	if err != nil {
-		log.Fatal("ERROR download database file", err)
+	var fetcher Fetcher = httpFetcher{client: http.DefaultClient}
+	if *cacheDir != "" {
+		fetcher = cacheFetcher{dir: *cacheDir, next: fetcher}
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		log.Fatal("ERROR download database file: response status is:", resp.Status)
+
+	var state sourceState
+	if !*force {
+		if err = readJSON(*stateFile, &state); err != nil && !os.IsNotExist(err) {
+			fatal("reading source state file", err)
+		}
+		// only the output recorded is known to be up to date.
+		if state.URL != *sourceURL || state.Output != *outputFile || state.Format != *format ||
+			state.Template != *templateFile || *outputFile == "-" || *dryRun {
+			state = sourceState{}
+		}
 	}
 
-	buff := bytes.NewBuffer([]byte{})
-	size, err := io.Copy(buff, resp.Body)
+	archive, err := fetcher.Fetch(*sourceURL, state.Validators)
+	if err == errNotModified {
+		logger.Info("database file not modified since last run, skipping generation", "url", *sourceURL)
+		os.Exit(exitNotModified)
+	}
 	if err != nil {
-		log.Fatal(err)
+		fatal("download database file", err)
 	}
-	ar, err := zip.NewReader(bytes.NewReader(buff.Bytes()), size)
+
+	ar, err := zip.NewReader(bytes.NewReader(archive.Data), int64(len(archive.Data)))
 	if err != nil {
-		log.Fatal("ERROR read zip:", err)
+		fatal("read zip", err)
 	}
 
 	var cf, zf io.ReadCloser
@@ -90,62 +318,202 @@ func main() {
 		case countryFile:
 			cf, err = f.Open()
 			if err != nil {
-				log.Fatal("ERROR open archive file:", err)
+				fatal("open archive file", err)
 			}
 		case zoneFile:
 			zf, err = f.Open()
 			if err != nil {
-				log.Fatal("ERROR open archive file:", err)
+				fatal("open archive file", err)
 			}
 		default:
 			continue
 		}
 	}
 	if cf == nil {
-		log.Fatal("ERROR country file not found in archive")
+		fatal("country file not found in archive", nil)
 	}
 	if zf == nil {
-		log.Fatal("ERROR zones file not found in archive")
+		fatal("zones file not found in archive", nil)
 	}
 	defer func() {
 		cf.Close()
 		zf.Close()
 	}()
 
-	countries, err := process(cf, zf)
+	countries, skipped, conflicts, err := process(cf, zf, iana, links, backzone)
+	if err != nil {
+		fatal("processing files", err)
+	}
+
+	for _, c := range conflicts {
+		logger.Warn("source conflict", "zone", c.Zone, "kind", c.Kind, "timezonedb", c.TimezoneDB, "iana", c.IANA, "resolution", c.Resolution)
+	}
+
+	switch {
+	case *conflictsFile == "" || *dryRun:
+	case *conflictsFile == "-":
+		err = json.NewEncoder(os.Stdout).Encode(conflicts)
+	default:
+		err = writeJSON(*conflictsFile, conflicts)
+	}
+	if err != nil {
+		fatal("writing conflict report", err)
+	}
+
+	if *failConflicts && len(conflicts) > 0 {
+		fatal("merged sources conflict, see the conflict report", nil)
+	}
+
+	if *isoCheck != "off" {
+		errs := validateCodes(countries)
+		for _, err := range errs {
+			if *isoCheck == "warn" {
+				logger.Warn("invalid country code", "err", err)
+			} else {
+				logger.Error("invalid country code", "err", err)
+			}
+		}
+		if len(errs) > 0 && *isoCheck == "fail" {
+			fatal("country codes failed ISO 3166-1 validation, use -iso-check=warn to generate regardless", nil)
+		}
+	}
+
+	ords, err := readOrdinals(*ordinalsFile)
 	if err != nil {
-		log.Fatal("ERROR processing files:", err)
+		fatal("reading ordinals file", err)
+	}
+	ords.assign(countries)
+
+	if *stripNames {
+		for i := range countries {
+			countries[i].Name = ""
+			countries[i].Synonyms = []string{}
+		}
 	}
 
 	err = os.Chdir(cwd)
 	if err != nil {
-		log.Fatal("ERROR switching to original working DIR:", err)
+		fatal("switching to original working DIR", err)
+	}
+
+	goOutput := *templateFile == "" && (*format == "go" || *format == "go-map")
+
+	data := templateData{
+		Package:     *pkgName,
+		Embed:       *embed && goOutput && *outputFile != "-",
+		Source:      dataSource,
+		License:     dataLicense,
+		Attribution: dataAttribution,
+		Provenance:  provenanceOf(*sourceURL, archive),
+		Countries:   countries,
+	}
+
+	if aliasLinks != nil {
+		data.Aliases = zoneAliases(countries, aliasLinks)
 	}
 
-	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_WRONLY, 0777)
+	src, err := emitter.Emit(data)
 	if err != nil {
-		log.Fatal("ERROR writing/creating tz data file:", err)
+		fatal("rendering tz data", err)
+	}
+
+	if *dryRun {
+		current, err := os.ReadFile(*outputFile)
+		if err != nil && !os.IsNotExist(err) {
+			fatal("reading tz data file", err)
+		}
+		logReport(tz.Diff(tz.CurrentDataset(), countries))
+		logger.Info("dry run, nothing written", "file", *outputFile, "changed", !bytes.Equal(current, src))
+		return
+	}
+
+	if *outputFile == "-" {
+		if _, err = os.Stdout.Write(src); err != nil {
+			fatal("writing tz data to stdout", err)
+		}
+		return
+	}
+
+	if err = os.WriteFile(*outputFile, src, 0644); err != nil {
+		fatal("writing/creating tz data file", err)
+	}
+
+	if *templateFile == "" && *format == "json" {
+		if err = writeManifest(*outputFile, src); err != nil {
+			fatal("writing manifest file", err)
+		}
+	}
+
+	if data.Embed {
+		err = writeEmbedded(filepath.Dir(*outputFile), data)
+	} else if goOutput {
+		err = removeEmbedded(filepath.Dir(*outputFile))
+	}
+	if err != nil {
+		fatal("writing embedded tz data files", err)
+	}
+
+	if goOutput && data.Aliases != nil {
+		if err = writeAliases(filepath.Dir(*outputFile), data); err != nil {
+			fatal("writing zone aliases file", err)
+		}
+	}
+
+	if goOutput || (*templateFile == "" && *format == "json") {
+		if err = writeSchema(filepath.Join(filepath.Dir(*outputFile), schemaFile)); err != nil {
+			fatal("writing JSON schema file", err)
+		}
 	}
-	defer f.Close()
 
-	err = tmpl.Execute(f, countries)
+	if err = ords.write(*ordinalsFile); err != nil {
+		fatal("writing ordinals file", err)
+	}
+
+	err = writeJSON(*stateFile, sourceState{
+		URL:        *sourceURL,
+		Validators: archive.Validators,
+		Output:     *outputFile,
+		Format:     *format,
+		Template:   *templateFile,
+	})
 	if err != nil {
-		log.Fatal("ERROR executing template:", err)
+		fatal("writing source state file", err)
+	}
+
+	sum := runSummary{Countries: len(countries), Skipped: skipped}
+	for _, c := range countries {
+		sum.Zones += len(c.Zones)
 	}
 
-	f.Close()
+	logger.Info("generated tz data", "file", *outputFile, "countries", sum.Countries, "zones", sum.Zones, "skipped", len(sum.Skipped))
+
+	switch *summary {
+	case "":
+	case "-":
+		err = json.NewEncoder(os.Stdout).Encode(sum)
+	default:
+		err = writeJSON(*summary, sum)
+	}
+	if err != nil {
+		fatal("writing summary", err)
+	}
+}
 
-	// after file written run gofmt on file
-	cmd := exec.Command("gofmt", "-s", "-w", outputFile)
-	if err = cmd.Run(); err != nil {
-		log.Fatal("ERROR running gofmt:", err)
+// fatal logs msg, along with err when set, and exits.
+func fatal(msg string, err error) {
+	if err != nil {
+		logger.Error(msg, "err", err)
+	} else {
+		logger.Error(msg)
 	}
+	os.Exit(1)
 }
 
-func process(cf, zf io.ReadCloser) ([]tz.Country, error) {
+func process(cf, zf io.ReadCloser, iana ianaZones, links backwardLinks, backzone backzoneZones) ([]tz.Country, []skippedZone, []conflict, error) {
 
 	cmap := make(map[string]int)
 	countries := make([]tz.Country, 0, 10)
+	skipped := make([]skippedZone, 0)
 
 	// process countries
 
@@ -158,12 +526,13 @@ func process(cf, zf io.ReadCloser) ([]tz.Country, error) {
 			break
 		}
 		if err != nil {
-			log.Fatal(err)
+			return nil, nil, nil, err
 		}
 
 		c := tz.Country{
-			Code: row[countryCode],
-			Name: row[countryName],
+			Code:  row[countryCode],
+			Name:  row[countryName],
+			Zones: make([]tz.Zone, 0),
 		}
 		cmap[c.Code] = len(countries)
 
@@ -181,7 +550,7 @@ func process(cf, zf io.ReadCloser) ([]tz.Country, error) {
 			break
 		}
 		if err != nil {
-			log.Fatal(err)
+			return nil, nil, nil, err
 		}
 
 		z := tz.Zone{
@@ -190,82 +559,122 @@ func process(cf, zf io.ReadCloser) ([]tz.Country, error) {
 		}
 
 		// test zone is working in Go
-		_, err = time.LoadLocation(z.Name)
-		if err != nil {
-			fmt.Println("*********************ERROR:", err)
+		if err = tz.CheckLoadable(z.Name); err != nil {
+			logger.Warn("skipping zone not loadable by Go", "zone", z.Name, "country", z.CountryCode, "err", err)
+			skipped = append(skipped, skippedZone{Zone: z.Name, CountryCode: z.CountryCode, Reason: err.Error()})
 			continue
 		}
 
 		idx, ok := cmap[z.CountryCode]
 		if !ok {
+			logger.Warn("skipping zone of unknown country", "zone", z.Name, "country", z.CountryCode)
+			skipped = append(skipped, skippedZone{Zone: z.Name, CountryCode: z.CountryCode, Reason: "unknown country"})
 			continue
 		}
 
+		logger.Debug("adding zone", "zone", z.Name, "country", z.CountryCode)
+
 		countries[idx].Zones = append(countries[idx].Zones, z)
 	}
 
-	// sort alphabetically
-	sort.Sort(byCountryName(countries))
+	conflicts := make([]conflict, 0)
 
-	for _, c := range countries {
-		sort.Sort(byZoneName(c.Zones))
+	if iana != nil {
+		merged, found := mergeIANA(countries, cmap, iana)
+		skipped = append(skipped, merged...)
+		conflicts = append(conflicts, found...)
 	}
 
-	return countries, nil
-}
+	if links != nil {
+		skipped = append(skipped, addBackward(countries, cmap, links, backzone)...)
+	}
 
-var output = `package tz
+	countries = applyUserAssigned(countries, *userAssigned)
+	flagCommon(countries)
+	setSynonyms(countries)
 
-import "sync"
+	switch *sortCountries {
+	case "code":
+		sort.Sort(byCountryCode(countries))
+	default:
+		sort.Sort(byCountryName(countries))
+	}
 
-// GENERATED FILE DO NOT MODIFY DIRECTLY
+	for _, c := range countries {
+		switch *sortZones {
+		case "offset":
+			sort.Sort(byZoneOffset(c.Zones))
+		default:
+			sort.Sort(byZoneName(c.Zones))
+		}
+	}
 
-var (
-	once      sync.Once
-	mapped    map[string]Country
-	countries = []Country{
-			{{ range $c := .}}{
-				Code: "{{ $c.Code }}",
-				Name: "{{ $c.Name }}",
-				Zones: []Zone{
-					{{ range $z := $c.Zones }}{
+	return countries, skipped, conflicts, nil
+}
+
+// consts is the const block shared by the generated Go files.
+var consts = `{{ define "consts" }}const (
+	dataSource      = "{{ .Source }}"
+	dataLicense     = "{{ .License }}"
+	dataAttribution = "{{ .Attribution }}"
+
+	provenanceSourceURL  = {{ printf "%q" .Provenance.SourceURL }}
+	provenanceRelease    = {{ printf "%q" .Provenance.Release }}
+	provenanceDownloaded = {{ printf "%q" .Provenance.Downloaded }}
+	provenanceChecksum   = {{ printf "%q" .Provenance.Checksum }}
+	provenanceGenerator  = {{ printf "%q" .Provenance.Generator }}
+){{ end }}`
+
+var output = `{{ define "country" }}{
+				Code: "{{ .Code }}",
+				{{ if .Name }}Name: "{{ .Name }}",
+				{{ end }}Ordinal: {{ .Ordinal }},
+				Synonyms: []string{ {{ range $i, $s := .Synonyms }}{{ if $i }}, {{ end }}{{ printf "%q" $s }}{{ end }} },
+				{{ if .UserAssigned }}UserAssigned: true,
+				{{ end }}Zones: []Zone{
+					{{ range $z := .Zones }}{
 						CountryCode: "{{ $z.CountryCode }}",
 						Name: "{{ $z.Name }}",
-					},
+						{{ if $z.Common }}Common: true,
+						{{ end }}{{ if $z.Deprecated }}Deprecated: true,
+						{{ end }}{{ if $z.HistoricalAccuracy }}HistoricalAccuracy: {{ printf "%q" $z.HistoricalAccuracy }},
+						{{ end }}					},
 					{{ end }}
 				},
-			},
+			}{{ end }}{{ if .Embed }}//go:build tz_literal
+
+{{ end }}package {{ .Package }}
+
+// GENERATED FILE DO NOT MODIFY DIRECTLY
+
+{{ template "consts" . }}
+{{ if .MapLiteral }}
+var (
+	literalMapped = map[string]Country{
+			{{ range $c := .Countries }}"{{ $c.Code }}": {{ template "country" $c }},
+			{{ end }}
+	}
+	literalCountries = []Country{
+			{{ range $c := .Countries }}literalMapped["{{ $c.Code }}"],
 			{{ end }}
 	}
 )
 
-func init() {
-	// load + index countries into map
-	// for below functions.
-
-	once.Do(func() {
-		mapped = make(map[string]Country)
-
-		for i := 0; i < len(countries); i++ {
-			mapped[countries[i].Code] = countries[i]
-		}
-	})
+// loadData returns the countries compiled into the binary.
+func loadData() ([]Country, map[string]Country) {
+	return literalCountries, literalMapped
 }
-
-// GetCountries returns an array of all countries.
-// Most common use: for loading into a country dropdown
-// in HTML.
-func GetCountries() []Country {
-	return countries
+{{ else }}
+var literalCountries = []Country{
+		{{ range $c := .Countries }}{{ template "country" $c }},
+		{{ end }}
 }
 
-// GetCountry returns a single Country that matches the country
-// code passed and whether it was found
-func GetCountry(code string) (c Country, found bool) {
-	c, found = mapped[code]
-	return
+// loadData returns the countries compiled into the binary.
+func loadData() ([]Country, map[string]Country) {
+	return literalCountries, nil
 }
-`
+{{ end }}`
 
 // func main() {
 
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// manifestExt is appended to the name of a data artifact
+// to name its manifest.
+const manifestExt = ".sha256"
+
+// writeManifest writes the SHA-256 checksum of the artifact b,
+// written to filename, in the format of sha256sum so it can be
+// verified with sha256sum -c before being loaded.
+func writeManifest(filename string, b []byte) error {
+	line := checksum(b) + "  " + filepath.Base(filename) + "\n"
+	return os.WriteFile(filename+manifestExt, []byte(line), 0644)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/go-playground/tz"
+)
+
+// ordinals maps country codes to their stable ordinal.
+type ordinals map[string]int
+
+// readOrdinals reads the code,ordinal rows of filename,
+// a missing file has no ordinals.
+func readOrdinals(filename string) (ordinals, error) {
+	ords := make(ordinals)
+
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return ords, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		n, err := strconv.Atoi(row[1])
+		if err != nil {
+			return nil, err
+		}
+		ords[row[0]] = n
+	}
+
+	return ords, nil
+}
+
+// assign sets the Ordinal of each country, giving countries not
+// yet known the next unused ordinals in code order. Ordinals of
+// countries no longer present are kept so they're never reused.
+func (ords ordinals) assign(countries []tz.Country) {
+	next := 1
+	for _, n := range ords {
+		if n >= next {
+			next = n + 1
+		}
+	}
+
+	var added []string
+
+	for _, c := range countries {
+		if _, ok := ords[c.Code]; !ok {
+			added = append(added, c.Code)
+		}
+	}
+
+	sort.Strings(added)
+
+	for _, code := range added {
+		logger.Info("assigning country ordinal", "code", code, "ordinal", next)
+		ords[code] = next
+		next++
+	}
+
+	for i := range countries {
+		countries[i].Ordinal = ords[countries[i].Code]
+	}
+}
+
+// write writes the code,ordinal rows, in ordinal order, to filename.
+func (ords ordinals) write(filename string) error {
+	codes := make([]string, 0, len(ords))
+	for code := range ords {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return ords[codes[i]] < ords[codes[j]]
+	})
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	for _, code := range codes {
+		if err = w.Write([]string{code, strconv.Itoa(ords[code])}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
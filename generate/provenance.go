@@ -0,0 +1,70 @@
+package main
+
+import (
+	"runtime/debug"
+	"strconv"
+	"time"
+)
+
+// provenance records where the generated data came from,
+// compiled in for tz.Provenance.
+type provenance struct {
+	SourceURL  string
+	Release    string
+	Downloaded string
+	Checksum   string
+	Generator  string
+}
+
+// provenanceOf returns the provenance of the data generated
+// from the archive downloaded from url.
+func provenanceOf(url string, a Archive) provenance {
+	p := provenance{
+		SourceURL: url,
+		Release:   a.Validators.ETag,
+		Checksum:  checksum(a.Data),
+		Generator: generatorVersion(),
+	}
+
+	if p.Release == "" {
+		p.Release = a.Validators.LastModified
+	}
+
+	if !a.Downloaded.IsZero() {
+		p.Downloaded = a.Downloaded.UTC().Format(time.RFC3339)
+	}
+
+	// strip the quotes of the ETag.
+	if unquoted, err := strconv.Unquote(p.Release); err == nil {
+		p.Release = unquoted
+	}
+
+	return p
+}
+
+// generatorVersion returns the VCS revision the generator was
+// built from, else its module version.
+func generatorVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	var revision, modified string
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value
+		}
+	}
+
+	if revision == "" {
+		return info.Main.Version
+	}
+	if modified == "true" {
+		revision += "-dirty"
+	}
+	return revision
+}
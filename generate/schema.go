@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+
+	"github.com/go-playground/tz"
+)
+
+const schemaFile = "tz.schema.json"
+
+// schemaPatterns contains the value patterns for
+// fields that hold ISO 3166-1 alpha-2 codes.
+var schemaPatterns = map[string]string{
+	"Code":        "^[A-Z]{2}$",
+	"CountryCode": "^[A-Z]{2}$",
+}
+
+// writeSchema writes a JSON Schema describing the JSON encoding
+// of the countries returned by tz.GetCountries.
+func writeSchema(filename string) error {
+	defs := make(map[string]interface{})
+
+	schema := map[string]interface{}{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         "https://github.com/go-playground/tz/tz.schema.json",
+		"title":       "Countries",
+		"description": "Timezone Country and Zone data generated from timezonedb.com",
+		"type":        "array",
+		"items":       schemaFor(reflect.TypeOf(tz.Country{}), defs),
+		"$defs":       defs,
+	}
+
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644)
+}
+
+// schemaFor returns the schema for t, adding struct
+// definitions to defs and referencing them.
+func schemaFor(t reflect.Type, defs map[string]interface{}) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Struct:
+		if _, ok := defs[t.Name()]; !ok {
+			props := make(map[string]interface{})
+			required := make([]string, 0, t.NumField())
+
+			// reserve name to stop recursion
+			defs[t.Name()] = nil
+
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				if f.PkgPath != "" {
+					continue
+				}
+
+				s := schemaFor(f.Type, defs)
+				if p, ok := schemaPatterns[f.Name]; ok {
+					s["pattern"] = p
+				}
+
+				props[f.Name] = s
+				required = append(required, f.Name)
+			}
+
+			defs[t.Name()] = map[string]interface{}{
+				"type":                 "object",
+				"properties":           props,
+				"required":             required,
+				"additionalProperties": false,
+			}
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  []string{"array", "null"},
+			"items": schemaFor(t.Elem(), defs),
+		}
+
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
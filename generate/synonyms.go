@@ -0,0 +1,48 @@
+package main
+
+import "github.com/go-playground/tz"
+
+// synonyms contains the common and former English names of
+// countries not found within their names, eg. Burma for Myanmar,
+// fed to the name search.
+var synonyms = map[string][]string{
+	"AE": {"UAE"},
+	"BF": {"Upper Volta"},
+	"BJ": {"Dahomey"},
+	"BY": {"Byelorussia", "Belorussia"},
+	"CD": {"DR Congo", "DRC", "Congo-Kinshasa", "Zaire"},
+	"CG": {"Republic of the Congo", "Congo-Brazzaville"},
+	"CI": {"Ivory Coast"},
+	"CV": {"Cape Verde"},
+	"CZ": {"Czech Republic"},
+	"GB": {"UK", "Great Britain", "Britain", "England", "Scotland", "Wales", "Northern Ireland"},
+	"GY": {"British Guiana"},
+	"IR": {"Persia"},
+	"KH": {"Kampuchea"},
+	"KN": {"St Kitts and Nevis"},
+	"KP": {"North Korea"},
+	"KR": {"South Korea"},
+	"LA": {"Laos"},
+	"LC": {"St Lucia"},
+	"LK": {"Ceylon"},
+	"MM": {"Burma"},
+	"NL": {"Holland"},
+	"RU": {"Russia"},
+	"SR": {"Dutch Guiana"},
+	"SY": {"Syria"},
+	"SZ": {"Swaziland"},
+	"TL": {"East Timor"},
+	"TR": {"Türkiye"},
+	"US": {"USA", "United States"},
+	"VA": {"Vatican", "Vatican City"},
+	"VC": {"St Vincent and the Grenadines"},
+	"VN": {"Vietnam"},
+	"ZW": {"Rhodesia"},
+}
+
+// setSynonyms sets the Synonyms of the countries from synonyms.
+func setSynonyms(countries []tz.Country) {
+	for i := range countries {
+		countries[i].Synonyms = append([]string{}, synonyms[countries[i].Code]...)
+	}
+}
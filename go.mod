@@ -1,3 +1,3 @@
 module github.com/go-playground/tz
 
-go 1.17.0
+go 1.21
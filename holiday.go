@@ -0,0 +1,78 @@
+package tz
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Holiday is a single public holiday of a Country.
+type Holiday struct {
+	CountryCode string
+	Name        string
+
+	// Date is the day of the holiday, at midnight UTC;
+	// use its year, month and day in the country's zones.
+	Date time.Time
+}
+
+// HolidayProvider provides the public holidays of countries,
+// keyed by the country codes of this package. Holiday libraries
+// implement it and register themselves with RegisterHolidayProvider.
+type HolidayProvider interface {
+	// Holidays returns the holidays of the country code
+	// passed in year, sorted by Date.
+	Holidays(countryCode string, year int) ([]Holiday, error)
+}
+
+var (
+	holidayProvidersMu sync.RWMutex
+	holidayProviders   = make(map[string]HolidayProvider)
+)
+
+// RegisterHolidayProvider makes a HolidayProvider available by the
+// name passed, typically from the init function of the package
+// implementing it. It panics when called twice for the same name
+// or when p is nil.
+func RegisterHolidayProvider(name string, p HolidayProvider) {
+	holidayProvidersMu.Lock()
+	defer holidayProvidersMu.Unlock()
+
+	if p == nil {
+		panic("tz: RegisterHolidayProvider provider is nil")
+	}
+	if _, dup := holidayProviders[name]; dup {
+		panic("tz: RegisterHolidayProvider called twice for provider " + name)
+	}
+	holidayProviders[name] = p
+}
+
+// GetHolidayProvider returns the HolidayProvider registered by the
+// name passed and whether it was found
+func GetHolidayProvider(name string) (p HolidayProvider, found bool) {
+	holidayProvidersMu.RLock()
+	defer holidayProvidersMu.RUnlock()
+
+	p, found = holidayProviders[name]
+	return
+}
+
+// HolidayProviders returns the sorted names of the registered
+// HolidayProviders.
+func HolidayProviders() []string {
+	holidayProvidersMu.RLock()
+	defer holidayProvidersMu.RUnlock()
+
+	names := make([]string, 0, len(holidayProviders))
+	for name := range holidayProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Holidays returns the holidays of the country in year,
+// as provided by p.
+func (c Country) Holidays(p HolidayProvider, year int) ([]Holiday, error) {
+	return p.Holidays(c.Code, year)
+}
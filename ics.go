@@ -0,0 +1,126 @@
+package tz
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WriteTransitionsICS writes an iCalendar (.ics) calendar with an
+// event for each transition of the zones passed from from until to,
+// for subscribing to upcoming clock changes. For a country pass the
+// names of its Zones.
+func WriteTransitionsICS(w io.Writer, from, to time.Time, zones ...string) error {
+	var ts []Transition
+
+	for _, zone := range zones {
+		zts, err := Transitions(zone, from, to)
+		if err != nil {
+			return err
+		}
+		ts = append(ts, zts...)
+	}
+
+	sort.SliceStable(ts, func(i, j int) bool {
+		return ts[i].At.Before(ts[j].At)
+	})
+
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		bw.WriteString(icsFold(s))
+		bw.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//go-playground//tz//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:Clock changes")
+
+	for _, t := range ts {
+		at := t.At.Format("20060102T150405Z")
+
+		line("BEGIN:VEVENT")
+		line("UID:" + strings.ReplaceAll(t.Zone, "/", "-") + "-" + strconv.FormatInt(t.At.Unix(), 10) + "@tz.go-playground")
+		line("DTSTAMP:" + stamp)
+		line("DTSTART:" + at)
+		line("DTEND:" + at)
+		line("SUMMARY:" + icsEscape(t.Zone+": "+t.description()))
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return bw.Flush()
+}
+
+// description describes the transition, eg.
+// "clocks go forward 1 hour (EST to EDT)".
+func (t Transition) description() string {
+	change := "clocks change"
+	switch diff := t.After - t.Before; {
+	case diff > 0:
+		change = "clocks go forward " + spelledOut(diff)
+	case diff < 0:
+		change = "clocks go back " + spelledOut(-diff)
+	}
+	return fmt.Sprintf("%s (%s to %s)", change, t.BeforeName, t.AfterName)
+}
+
+// spelledOut returns the positive Offset o in words,
+// eg. "1 hour 30 minutes".
+func spelledOut(o Offset) string {
+	unit := func(n int, name string) string {
+		if n == 1 {
+			return "1 " + name
+		}
+		return strconv.Itoa(n) + " " + name + "s"
+	}
+
+	hours, mins := int(o)/60, int(o)%60
+	switch {
+	case hours == 0:
+		return unit(mins, "minute")
+	case mins == 0:
+		return unit(hours, "hour")
+	default:
+		return unit(hours, "hour") + " " + unit(mins, "minute")
+	}
+}
+
+// icsEscape escapes s as an iCalendar TEXT value.
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// icsFold folds content line s into lines of at most 75 octets,
+// continuation lines starting with a space, without splitting
+// UTF-8 sequences.
+func icsFold(s string) string {
+	if len(s) <= 75 {
+		return s
+	}
+
+	var b strings.Builder
+	limit := 75
+
+	for len(s) > limit {
+		i := limit
+		for i > 0 && s[i]&0xC0 == 0x80 {
+			i--
+		}
+		b.WriteString(s[:i])
+		b.WriteString("\r\n ")
+		s = s[i:]
+		limit = 74
+	}
+	b.WriteString(s)
+
+	return b.String()
+}
@@ -0,0 +1,90 @@
+package tz
+
+import "time"
+
+// leapSecond contains the TAI-UTC offset in effect from
+// the UTC instant onward.
+type leapSecond struct {
+	utc    time.Time
+	offset time.Duration
+}
+
+// gpsOffset is the constant TAI-GPS offset; GPS time was aligned
+// with UTC at its 1980-01-06 epoch, when TAI-UTC was 19s.
+const gpsOffset = 19 * time.Second
+
+// leapSeconds is the IERS leap second table, oldest first.
+// see https://hpiers.obspm.fr/iers/bul/bulc/Leap_Second.dat
+var leapSeconds = []leapSecond{
+	{utc: time.Date(1972, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 10 * time.Second},
+	{utc: time.Date(1972, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 11 * time.Second},
+	{utc: time.Date(1973, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 12 * time.Second},
+	{utc: time.Date(1974, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 13 * time.Second},
+	{utc: time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 14 * time.Second},
+	{utc: time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 15 * time.Second},
+	{utc: time.Date(1977, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 16 * time.Second},
+	{utc: time.Date(1978, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 17 * time.Second},
+	{utc: time.Date(1979, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 18 * time.Second},
+	{utc: time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 19 * time.Second},
+	{utc: time.Date(1981, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 20 * time.Second},
+	{utc: time.Date(1982, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 21 * time.Second},
+	{utc: time.Date(1983, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 22 * time.Second},
+	{utc: time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 23 * time.Second},
+	{utc: time.Date(1988, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 24 * time.Second},
+	{utc: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 25 * time.Second},
+	{utc: time.Date(1991, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 26 * time.Second},
+	{utc: time.Date(1992, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 27 * time.Second},
+	{utc: time.Date(1993, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 28 * time.Second},
+	{utc: time.Date(1994, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 29 * time.Second},
+	{utc: time.Date(1996, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 30 * time.Second},
+	{utc: time.Date(1997, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 31 * time.Second},
+	{utc: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 32 * time.Second},
+	{utc: time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 33 * time.Second},
+	{utc: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 34 * time.Second},
+	{utc: time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 35 * time.Second},
+	{utc: time.Date(2015, time.July, 1, 0, 0, 0, 0, time.UTC), offset: 36 * time.Second},
+	{utc: time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), offset: 37 * time.Second},
+}
+
+// TAIOffset returns the TAI-UTC offset in effect at the UTC time t.
+// Times before 1972 return the initial 10s offset as UTC was not
+// yet defined in whole leap seconds.
+func TAIOffset(t time.Time) time.Duration {
+	for i := len(leapSeconds) - 1; i > 0; i-- {
+		if !t.Before(leapSeconds[i].utc) {
+			return leapSeconds[i].offset
+		}
+	}
+	return leapSeconds[0].offset
+}
+
+// UTCToTAI converts the UTC time t to International Atomic Time.
+// The returned value carries the TAI reading in a UTC time.Time.
+func UTCToTAI(t time.Time) time.Time {
+	t = t.UTC()
+	return t.Add(TAIOffset(t))
+}
+
+// TAIToUTC converts a TAI reading, as returned by UTCToTAI,
+// back to UTC.
+func TAIToUTC(t time.Time) time.Time {
+	t = t.UTC()
+	for i := len(leapSeconds) - 1; i > 0; i-- {
+		if u := t.Add(-leapSeconds[i].offset); !u.Before(leapSeconds[i].utc) {
+			return u
+		}
+	}
+	return t.Add(-leapSeconds[0].offset)
+}
+
+// UTCToGPS converts the UTC time t to GPS time.
+// The returned value carries the GPS reading in a UTC time.Time.
+func UTCToGPS(t time.Time) time.Time {
+	return UTCToTAI(t).Add(-gpsOffset)
+}
+
+// GPSToUTC converts a GPS reading, as returned by UTCToGPS,
+// back to UTC.
+func GPSToUTC(t time.Time) time.Time {
+	return TAIToUTC(t.Add(gpsOffset))
+}
@@ -0,0 +1,52 @@
+package tz
+
+import "strings"
+
+// codeCorrections maps wrong but commonly used country codes,
+// mostly reserved by ISO 3166-1, to the code meant.
+var codeCorrections = map[string]string{
+	"UK": "GB", // United Kingdom
+	"EL": "GR", // Greece, per the EU
+	"FX": "FR", // Metropolitan France
+	"BU": "MM", // Burma
+	"TP": "TL", // East Timor
+	"ZR": "CD", // Zaire
+}
+
+// successorCodes maps the codes of dissolved countries to the
+// successor they're commonly used for, applied per LenientPolicy.
+var successorCodes = map[string]string{
+	"SU": "RU", // Soviet Union
+	"YU": "RS", // Yugoslavia
+	"CS": "RS", // Serbia and Montenegro
+}
+
+// LenientPolicy determines the corrections LenientLookup applies
+// beyond the unambiguous ones.
+type LenientPolicy struct {
+	// Successors corrects the codes of dissolved countries to
+	// their commonly assumed successor, eg. SU to RU.
+	Successors bool
+}
+
+// LenientLookup returns the Country matching code, ignoring case and
+// surrounding whitespace and correcting wrong but common codes such as
+// UK for GB and EL for GR, along with the code it matched and whether
+// it was found. Intended for imported data; GetCountry is strict.
+func LenientLookup(code string, policy LenientPolicy) (c Country, corrected string, found bool) {
+	load()
+
+	corrected = strings.ToUpper(strings.TrimSpace(code))
+
+	if fixed, ok := codeCorrections[corrected]; ok {
+		corrected = fixed
+	} else if fixed, ok := successorCodes[corrected]; ok && policy.Successors {
+		corrected = fixed
+	}
+
+	c, found = mapped[corrected]
+	if !found {
+		return Country{}, "", false
+	}
+	return c, corrected, true
+}
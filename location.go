@@ -0,0 +1,189 @@
+package tz
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LocationCacheOptions configures the cache of the *time.Location
+// of each zone loaded by LoadLocation. The zero value is unbounded.
+type LocationCacheOptions struct {
+	// MaxEntries bounds the number of locations cached, evicting
+	// the least recently used, zero leaving it unbounded.
+	MaxEntries int
+
+	// OnHit, OnMiss and OnEvict, when set, are called with the zone
+	// name on each cache hit, miss and eviction, eg. for metrics.
+	// They must be safe for concurrent use.
+	OnHit, OnMiss, OnEvict func(name string)
+}
+
+// locationCache caches locations in a sync.Map when unbounded,
+// else in a mutex guarded LRU list.
+type locationCache struct {
+	opts LocationCacheOptions
+
+	unbounded sync.Map
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+// lruEntry is an element of the LRU list.
+type lruEntry struct {
+	name string
+	loc  *time.Location
+}
+
+// locations is the current locationCache.
+var locations atomic.Pointer[locationCache]
+
+func init() {
+	locations.Store(newLocationCache(LocationCacheOptions{}))
+}
+
+func newLocationCache(opts LocationCacheOptions) *locationCache {
+	c := &locationCache{opts: opts}
+	if opts.MaxEntries > 0 {
+		c.lru = list.New()
+		c.entries = make(map[string]*list.Element, opts.MaxEntries)
+	}
+	return c
+}
+
+// ConfigureLocationCache replaces the cache of LoadLocation with
+// an empty one configured per opts, eg. bounding it for memory
+// constrained consumers; PreloadLocations fills an unbounded one.
+func ConfigureLocationCache(opts LocationCacheOptions) {
+	locations.Store(newLocationCache(opts))
+}
+
+// get returns the cached location of name and whether it was found.
+func (c *locationCache) get(name string) (*time.Location, bool) {
+	if c.lru == nil {
+		loc, ok := c.unbounded.Load(name)
+		if !ok {
+			return nil, false
+		}
+		return loc.(*time.Location), true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[name]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*lruEntry).loc, true
+}
+
+// put caches loc as the location of name, returning the location
+// cached for it, which differs when another goroutine cached first.
+func (c *locationCache) put(name string, loc *time.Location) *time.Location {
+	if c.lru == nil {
+		actual, _ := c.unbounded.LoadOrStore(name, loc)
+		return actual.(*time.Location)
+	}
+
+	c.mu.Lock()
+
+	if e, ok := c.entries[name]; ok {
+		c.lru.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*lruEntry).loc
+	}
+
+	c.entries[name] = c.lru.PushFront(&lruEntry{name: name, loc: loc})
+
+	var evicted []string
+	for c.lru.Len() > c.opts.MaxEntries {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*lruEntry).name)
+		evicted = append(evicted, e.Value.(*lruEntry).name)
+	}
+
+	c.mu.Unlock()
+
+	if c.opts.OnEvict != nil {
+		for _, name := range evicted {
+			c.opts.OnEvict(name)
+		}
+	}
+	return loc
+}
+
+// delete removes the location of name from the cache.
+func (c *locationCache) delete(name string) {
+	if c.lru == nil {
+		c.unbounded.Delete(name)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[name]; ok {
+		c.lru.Remove(e)
+		delete(c.entries, name)
+	}
+}
+
+// LoadLocation returns the *time.Location of the zone name passed,
+// as time.LoadLocation does, caching it so that each zone is only
+// loaded once, or per ConfigureLocationCache. Zones registered with
+// RegisterZone return the location registered.
+func LoadLocation(name string) (*time.Location, error) {
+	c := locations.Load()
+
+	if loc, ok := c.get(name); ok {
+		if c.opts.OnHit != nil {
+			c.opts.OnHit(name)
+		}
+		return loc, nil
+	}
+
+	if c.opts.OnMiss != nil {
+		c.opts.OnMiss(name)
+	}
+
+	if loc, ok := customLocations[name]; ok {
+		return c.put(name, loc), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.put(name, loc), nil
+}
+
+// PreloadLocations loads and caches the *time.Location of the zones
+// passed, or of all zones when none are passed, so the cost is paid
+// at startup rather than on first use.
+func PreloadLocations(zones ...string) error {
+	load()
+
+	if len(zones) == 0 {
+		for _, z := range zonesList {
+			zones = append(zones, z.Name)
+		}
+	}
+
+	var errs []error
+
+	for _, name := range zones {
+		if _, err := LoadLocation(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
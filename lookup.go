@@ -0,0 +1,228 @@
+package tz
+
+import (
+	"fmt"
+	"sort"
+)
+
+var (
+	zones         map[string]Zone
+	zonesList     []Zone
+	zoneCountries map[string][]string
+	ordinals      map[int]int
+)
+
+// indexZones indexes the zones for below lookup functions.
+func indexZones() {
+	zones = make(map[string]Zone)
+	zonesList = nil
+	zoneCountries = make(map[string][]string)
+	ordinals = make(map[int]int, len(countries))
+
+	for i := 0; i < len(countries); i++ {
+		ordinals[countries[i].Ordinal] = i
+
+		for _, z := range countries[i].Zones {
+			zoneCountries[z.Name] = append(zoneCountries[z.Name], countries[i].Code)
+
+			// a shared zone is owned by the first country using it, but
+			// for one of a user-assigned code, eg. Kosovo sharing
+			// Europe/Belgrade, which doesn't take it over.
+			if prev, ok := zones[z.Name]; ok {
+				if mapped[prev.CountryCode].UserAssigned && !countries[i].UserAssigned {
+					zones[z.Name] = z
+				}
+				continue
+			}
+			zones[z.Name] = z
+		}
+	}
+
+	for _, z := range zones {
+		zonesList = append(zonesList, z)
+	}
+
+	sort.Slice(zonesList, func(i, j int) bool {
+		return zonesList[i].Name < zonesList[j].Name
+	})
+}
+
+// GetAllZones returns an array of all zones, sorted alphabetically
+// by name, each appearing once.
+// Most common use: for loading into a flat zone dropdown
+// in HTML.
+func GetAllZones() []Zone {
+	load()
+
+	zs := make([]Zone, len(zonesList))
+	copy(zs, zonesList)
+	return zs
+}
+
+// CommonZones returns the curated subset of commonly selected zones,
+// sorted alphabetically by name.
+// Most common use: for a short zone dropdown with a "show all"
+// option falling back to GetAllZones.
+func CommonZones() []Zone {
+	load()
+
+	var zs []Zone
+	for _, z := range zonesList {
+		if z.Common {
+			zs = append(zs, z)
+		}
+	}
+	return zs
+}
+
+// ZoneCount returns the number of distinct zones.
+func ZoneCount() int {
+	load()
+
+	return len(zonesList)
+}
+
+// CountryCount returns the number of countries.
+func CountryCount() int {
+	load()
+
+	return len(countries)
+}
+
+// GetZone returns a single Zone that matches the zone
+// name passed and whether it was found
+func GetZone(name string) (z Zone, found bool) {
+	load()
+
+	z, found = zones[name]
+	return
+}
+
+// CountriesMap returns all countries keyed by country code.
+// The returned map is a copy, modifying it does not affect
+// the package data.
+func CountriesMap() map[string]Country {
+	load()
+
+	m := make(map[string]Country, len(mapped))
+	for code, c := range mapped {
+		m[code] = c
+	}
+	return m
+}
+
+// CountryByOrdinal returns a single Country that matches the
+// Ordinal passed and whether it was found
+func CountryByOrdinal(ordinal int) (c Country, found bool) {
+	load()
+
+	idx, found := ordinals[ordinal]
+	if !found {
+		return
+	}
+	return countries[idx], true
+}
+
+// GetCountriesByZone returns all countries the zone name or alias
+// passed is used by, the zone's own country first, or nil when not
+// found; most zones belong to a single country but some, eg.
+// Europe/Zurich, may also cover neighbouring countries. Zones are
+// only shared between countries when the data was generated merging
+// zone1970.tab with -precedence iana, see generate/generate.md.
+func GetCountriesByZone(name string) []Country {
+	load()
+
+	if canonical, ok := zoneAliases[name]; ok {
+		name = canonical
+	}
+
+	z, found := zones[name]
+	if !found {
+		return nil
+	}
+
+	codes := z.CountryCodes()
+
+	cs := make([]Country, len(codes))
+	for i, code := range codes {
+		cs[i] = mapped[code]
+	}
+	return cs
+}
+
+// ZoneCountries returns the countries of the zone name or alias
+// passed, as GetCountriesByZone.
+func ZoneCountries(zoneName string) []Country {
+	return GetCountriesByZone(zoneName)
+}
+
+// Country returns the country of the Zone's CountryCode,
+// eg. for rendering "America/Toronto — Canada".
+func (z Zone) Country() Country {
+	load()
+
+	return mapped[z.CountryCode]
+}
+
+// CountryCodes returns the codes of all countries the zone is used
+// by, the first being the Zone's own CountryCode.
+func (z Zone) CountryCodes() []string {
+	load()
+
+	codes := []string{z.CountryCode}
+
+	for _, code := range zoneCountries[z.Name] {
+		if code != z.CountryCode {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// MustCountry returns the Country that matches the country code
+// passed and panics if it is not found.
+// Intended for tests and initialization where an unknown code
+// is a programmer error.
+func MustCountry(code string) Country {
+	c, found := GetCountry(code)
+	if !found {
+		panic(fmt.Sprintf("tz: unknown country code %q", code))
+	}
+	return c
+}
+
+// MustZone returns the Zone that matches the zone name passed
+// and panics if it is not found.
+// Intended for tests and initialization where an unknown zone
+// is a programmer error.
+func MustZone(name string) Zone {
+	z, found := GetZone(name)
+	if !found {
+		panic(fmt.Sprintf("tz: unknown zone %q", name))
+	}
+	return z
+}
+
+// RangeCountries calls fn for each country, in the same order as
+// GetCountries, until fn returns false.
+// Unlike GetCountries no slice is allocated.
+func RangeCountries(fn func(Country) bool) {
+	load()
+
+	for i := 0; i < len(countries); i++ {
+		if !fn(countries[i]) {
+			return
+		}
+	}
+}
+
+// LookupCountryCode returns a single Country that matches the
+// country code passed and whether it was found, without allocating.
+// Unlike GetCountry no copy is made, so the Zones of the returned
+// Country must not be modified.
+func LookupCountryCode(code [2]byte) (c Country, found bool) {
+	load()
+
+	c, found = mapped[string(code[:])]
+	return
+}
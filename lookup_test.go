@@ -0,0 +1,116 @@
+package tz
+
+import "testing"
+
+func TestLookupAllocs(t *testing.T) {
+	load()
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{
+			name: "LookupCountryCode",
+			fn: func() {
+				if _, found := LookupCountryCode([2]byte{'U', 'S'}); !found {
+					t.Fatal("US not found")
+				}
+			},
+		},
+		{
+			name: "RangeCountries",
+			fn: func() {
+				RangeCountries(func(Country) bool { return true })
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.fn); allocs != 0 {
+				t.Errorf("got %v allocs, want 0", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkLookupCountryCode(b *testing.B) {
+	load()
+	b.ReportAllocs()
+
+	code := [2]byte{'U', 'S'}
+
+	for i := 0; i < b.N; i++ {
+		LookupCountryCode(code)
+	}
+}
+
+func BenchmarkRangeCountries(b *testing.B) {
+	load()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		RangeCountries(func(Country) bool { return true })
+	}
+}
+
+func TestIndexZonesUserAssigned(t *testing.T) {
+	load()
+
+	prevCountries, prevMapped := countries, mapped
+	defer func() {
+		countries, mapped = prevCountries, prevMapped
+		indexZones()
+	}()
+
+	// sorted by name, Kosovo comes before Serbia.
+	countries = []Country{
+		{Code: "XK", Name: "Kosovo", UserAssigned: true, Zones: []Zone{{CountryCode: "XK", Name: "Europe/Belgrade"}}},
+		{Code: "RS", Name: "Serbia", Zones: []Zone{{CountryCode: "RS", Name: "Europe/Belgrade"}}},
+	}
+	mapped = map[string]Country{"XK": countries[0], "RS": countries[1]}
+	indexZones()
+
+	z, found := GetZone("Europe/Belgrade")
+	if !found {
+		t.Fatal("Europe/Belgrade not found")
+	}
+	if z.CountryCode != "RS" {
+		t.Errorf("got %q, want %q", z.CountryCode, "RS")
+	}
+	if codes := z.CountryCodes(); len(codes) != 2 || codes[0] != "RS" || codes[1] != "XK" {
+		t.Errorf("got %v, want [RS XK]", codes)
+	}
+	if all := GetAllZones(); len(all) != 1 || all[0].CountryCode != "RS" {
+		t.Errorf("got %v, want the RS zone only", all)
+	}
+}
+
+func TestAliasCountries(t *testing.T) {
+	// legacy names linking to a zone of another country in tzdb,
+	// eg. Iceland to Africa/Abidjan, resolve to the zone of their own.
+	tests := []struct {
+		alias string
+		zone  string
+		code  string
+	}{
+		{alias: "Iceland", zone: "Atlantic/Reykjavik", code: "IS"},
+		{alias: "Africa/Asmera", zone: "Africa/Asmara", code: "ER"},
+		{alias: "America/Coral_Harbour", zone: "America/Atikokan", code: "CA"},
+		{alias: "Atlantic/Jan_Mayen", zone: "Arctic/Longyearbyen", code: "SJ"},
+		{alias: "Pacific/Ponape", zone: "Pacific/Pohnpei", code: "FM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			if zone := zoneAliases[tt.alias]; zone != tt.zone {
+				t.Errorf("got zone %q, want %q", zone, tt.zone)
+			}
+
+			cs := GetCountriesByZone(tt.alias)
+			if len(cs) == 0 || cs[0].Code != tt.code {
+				t.Errorf("got countries %v, want %s first", cs, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package tz
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Offset is an offset from UTC in minutes east of UTC,
+// eg. 330 for UTC+05:30.
+type Offset int
+
+// OffsetOf returns the Offset of t in its location.
+func OffsetOf(t time.Time) Offset {
+	_, secs := t.Zone()
+	return Offset(secs / 60)
+}
+
+// ParseOffset parses an offset formatted as by Offset.String,
+// "+05:30", also accepting "+0530", "+05" and "Z".
+func ParseOffset(s string) (Offset, error) {
+	if s == "Z" {
+		return 0, nil
+	}
+
+	if len(s) < 3 || (s[0] != '+' && s[0] != '-') {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	digits := s[1:]
+	switch len(digits) {
+	case 2:
+		digits += "00"
+	case 4:
+	case 5:
+		if digits[2] != ':' {
+			return 0, fmt.Errorf("tz: invalid offset %q", s)
+		}
+		digits = digits[:2] + digits[3:]
+	default:
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	hours, err := strconv.ParseUint(digits[:2], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	mins, err := strconv.ParseUint(digits[2:], 10, 8)
+	if err != nil || mins > 59 {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	o := Offset(hours*60 + mins)
+	if s[0] == '-' {
+		o = -o
+	}
+	return o, nil
+}
+
+// Duration returns the Offset as a time.Duration.
+func (o Offset) Duration() time.Duration {
+	return time.Duration(o) * time.Minute
+}
+
+// String returns the Offset formatted as "+05:30".
+func (o Offset) String() string {
+	sign := '+'
+	if o < 0 {
+		sign = '-'
+		o = -o
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, o/60, o%60)
+}
+
+// Compare returns -1, 0 or +1 when o is west of, equal to or
+// east of p, for use with slices.SortFunc and the like.
+func (o Offset) Compare(p Offset) int {
+	switch {
+	case o < p:
+		return -1
+	case o > p:
+		return 1
+	}
+	return 0
+}
+
+// ParseOffsetString parses the offset forms found in imported data,
+// those of ParseOffset optionally prefixed by "UTC" or "GMT", also
+// accepting hours alone, with decimal fractions, eg. "UTC+5.5" and
+// "GMT-7". "UTC" and "GMT" alone are a zero Offset.
+func ParseOffsetString(s string) (Offset, error) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+
+	for _, prefix := range []string{"UTC", "GMT"} {
+		if strings.HasPrefix(v, prefix) {
+			v = strings.TrimSpace(v[len(prefix):])
+			if v == "" {
+				return 0, nil
+			}
+			break
+		}
+	}
+
+	if o, err := ParseOffset(v); err == nil {
+		return o, nil
+	}
+
+	if len(v) < 2 || (v[0] != '+' && v[0] != '-') {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	if strings.Trim(v[1:], "0123456789.") != "" {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	hours, err := strconv.ParseFloat(v[1:], 64)
+	if err != nil || hours > 99 {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	mins := hours * 60
+	if mins != math.Trunc(mins) {
+		return 0, fmt.Errorf("tz: invalid offset %q", s)
+	}
+
+	o := Offset(mins)
+	if v[0] == '-' {
+		o = -o
+	}
+	return o, nil
+}
+
+// ZonesMatchingOffsetString returns the zones at the offset s, parsed
+// by ParseOffsetString, at the instant passed, sorted by name, for
+// mapping offsets in imported data onto candidate zones.
+func ZonesMatchingOffsetString(s string, at time.Time) ([]Zone, error) {
+	o, err := ParseOffsetString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	load()
+
+	var zs []Zone
+	for _, z := range zonesList {
+		if zoneOffset(z, at) == o {
+			zs = append(zs, z)
+		}
+	}
+	return zs, nil
+}
@@ -0,0 +1,52 @@
+package tz
+
+// Option controls the countries returned by GetCountries and GetCountry.
+type Option func(*options)
+
+type options struct {
+	withoutZones      bool
+	withoutDeprecated bool
+}
+
+// WithoutZones leaves the Zones of the countries returned nil,
+// eg. for country lists only needing the names.
+func WithoutZones() Option {
+	return func(o *options) {
+		o.withoutZones = true
+	}
+}
+
+// WithoutDeprecated leaves the Deprecated zones, included in data
+// generated with -backward, out of the Zones of the countries returned.
+func WithoutDeprecated() Option {
+	return func(o *options) {
+		o.withoutDeprecated = true
+	}
+}
+
+// withOptions returns c with its Zones per the options passed.
+func (c Country) withOptions(opts []Option) Country {
+	if len(opts) == 0 {
+		return c
+	}
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	switch {
+	case o.withoutZones:
+		c.Zones = nil
+	case o.withoutDeprecated:
+		zones := make([]Zone, 0, len(c.Zones))
+		for _, z := range c.Zones {
+			if !z.Deprecated {
+				zones = append(zones, z)
+			}
+		}
+		c.Zones = zones
+	}
+
+	return c
+}
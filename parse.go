@@ -0,0 +1,108 @@
+package tz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxSuggestions is the number of closest zones
+// a ZoneError suggests.
+const maxSuggestions = 3
+
+// ZoneError is returned by ParseZone for input not matching
+// any zone, suggesting the closest zone names.
+type ZoneError struct {
+	Input       string
+	Suggestions []string
+}
+
+// Error returns the error message, eg.
+// `tz: unknown zone "Amrica/Sao_Paulo", did you mean America/Sao_Paulo?`.
+func (e *ZoneError) Error() string {
+	msg := fmt.Sprintf("tz: unknown zone %q", e.Input)
+
+	switch n := len(e.Suggestions); n {
+	case 0:
+		return msg
+	case 1:
+		return msg + ", did you mean " + e.Suggestions[0] + "?"
+	default:
+		return msg + ", did you mean " + strings.Join(e.Suggestions[:n-1], ", ") + " or " + e.Suggestions[n-1] + "?"
+	}
+}
+
+// ParseZone returns the Zone named by input, ignoring surrounding
+// whitespace, case and the difference between spaces, dashes and
+// underscores, and resolving deprecated names such as "US/Eastern".
+// Input not matching any zone returns a *ZoneError.
+func ParseZone(input string) (Zone, error) {
+	load()
+
+	name := strings.TrimSpace(input)
+
+	if z, ok := resolveZone(name); ok {
+		return z, nil
+	}
+
+	return Zone{}, &ZoneError{Input: input, Suggestions: suggestZones(name, maxSuggestions)}
+}
+
+// Canonicalize maps each of the names passed to the name of its Zone,
+// as resolved by ParseZone, returning the names not resolving to any
+// zone separately, once each, in the order passed. Each distinct name
+// is resolved once and unresolved names go without suggestions, so
+// it suits migrating large numbers of stored zone names.
+func Canonicalize(names []string) (canonical map[string]string, unresolved []string) {
+	load()
+
+	canonical = make(map[string]string)
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if z, ok := resolveZone(strings.TrimSpace(name)); ok {
+			canonical[name] = z.Name
+		} else {
+			unresolved = append(unresolved, name)
+		}
+	}
+	return
+}
+
+// resolveZone returns the Zone named by the trimmed name passed,
+// as described by ParseZone, and whether it was found. Deprecated
+// zones resolve to the zone they alias when known.
+func resolveZone(name string) (Zone, bool) {
+	z, ok := lookupZone(name)
+	if ok && z.Deprecated {
+		if canonical, found := zones[zoneAliases[z.Name]]; found {
+			return canonical, true
+		}
+	}
+	return z, ok
+}
+
+// lookupZone returns the Zone named, aliased or slugged
+// by name and whether it was found.
+func lookupZone(name string) (Zone, bool) {
+	if z, ok := zones[name]; ok {
+		return z, true
+	}
+
+	if z, ok := zones[zoneAliases[name]]; ok {
+		return z, true
+	}
+
+	slug := slugify(name)
+
+	if z, ok := zoneSlugs[slug]; ok {
+		return z, true
+	}
+
+	z, ok := aliasSlugs[slug]
+	return z, ok
+}
@@ -0,0 +1,102 @@
+package tz
+
+import (
+	"sort"
+	"time"
+)
+
+// PickerOptions configures BuildPicker.
+type PickerOptions struct {
+	// At is the instant the zone offsets are determined at,
+	// the current time when zero.
+	At time.Time
+}
+
+// Picker contains everything a timezone picker needs,
+// grouped by Continent and then offset.
+type Picker struct {
+	Continents []PickerContinent
+}
+
+// PickerContinent contains a single Continent's zones,
+// grouped by offset west to east.
+type PickerContinent struct {
+	Continent Continent
+	Offsets   []PickerOffset
+}
+
+// PickerOffset contains the zones sharing a single offset.
+type PickerOffset struct {
+	Offset Offset
+	Label  string
+	Zones  []PickerZone
+}
+
+// PickerZone contains a single Zone and its display label.
+type PickerZone struct {
+	Zone  Zone
+	Label string
+}
+
+// BuildPicker returns all zones grouped by Continent and then by
+// their offset at opts.At, each with a display label,
+// eg. "(UTC-05:00) New York (America)".
+func BuildPicker(opts PickerOptions) (Picker, error) {
+	load()
+
+	at := opts.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	grouped := make(map[Continent]map[Offset][]PickerZone)
+
+	for _, z := range zonesList {
+		loc, err := LoadLocation(z.Name)
+		if err != nil {
+			return Picker{}, err
+		}
+
+		offset := OffsetOf(at.In(loc))
+
+		cont := z.Continent()
+		if grouped[cont] == nil {
+			grouped[cont] = make(map[Offset][]PickerZone)
+		}
+
+		grouped[cont][offset] = append(grouped[cont][offset], PickerZone{
+			Zone:  z,
+			Label: "(UTC" + offset.String() + ") " + z.DisplayName(),
+		})
+	}
+
+	var p Picker
+
+	for cont, offsets := range grouped {
+		pc := PickerContinent{Continent: cont}
+
+		for offset, zones := range offsets {
+			sort.Slice(zones, func(i, j int) bool {
+				return zones[i].Label < zones[j].Label
+			})
+
+			pc.Offsets = append(pc.Offsets, PickerOffset{
+				Offset: offset,
+				Label:  "UTC" + offset.String(),
+				Zones:  zones,
+			})
+		}
+
+		sort.Slice(pc.Offsets, func(i, j int) bool {
+			return pc.Offsets[i].Offset < pc.Offsets[j].Offset
+		})
+
+		p.Continents = append(p.Continents, pc)
+	}
+
+	sort.Slice(p.Continents, func(i, j int) bool {
+		return p.Continents[i].Continent < p.Continents[j].Continent
+	})
+
+	return p, nil
+}
@@ -0,0 +1,37 @@
+package tz
+
+import "time"
+
+// DataProvenance describes where the compiled in data came from,
+// as recorded by the generator. Fields are empty when unrecorded.
+type DataProvenance struct {
+	// SourceURL is the URL the database archive was downloaded from.
+	SourceURL string
+
+	// Release identifies the version of the archive,
+	// its ETag or else its Last-Modified date.
+	Release string
+
+	// Downloaded is when the archive was downloaded.
+	Downloaded time.Time
+
+	// Checksum is the hex encoded SHA-256 of the archive.
+	Checksum string
+
+	// Generator is the VCS revision or version of the generator.
+	Generator string
+}
+
+// Provenance returns where the compiled in data came from,
+// for proving the origin of the data in audits.
+func Provenance() DataProvenance {
+	downloaded, _ := time.Parse(time.RFC3339, provenanceDownloaded)
+
+	return DataProvenance{
+		SourceURL:  provenanceSourceURL,
+		Release:    provenanceRelease,
+		Downloaded: downloaded,
+		Checksum:   provenanceChecksum,
+		Generator:  provenanceGenerator,
+	}
+}
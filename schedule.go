@@ -0,0 +1,185 @@
+package tz
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Clock is a wall clock time of day.
+type Clock struct {
+	Hour, Minute, Second int
+}
+
+// GapPolicy determines how a wall clock time that doesn't exist,
+// skipped by a spring-forward transition, is resolved.
+type GapPolicy int
+
+// Gap policies
+const (
+	// GapShift moves the time forward by the length of the gap,
+	// eg. 02:30 becomes 03:30.
+	GapShift GapPolicy = iota
+
+	// GapNext takes the first instant after the gap, eg. 03:00.
+	GapNext
+
+	// GapReject rejects the time; NextOccurrence skips that day.
+	GapReject
+)
+
+// OverlapPolicy determines how a wall clock time that occurs twice,
+// repeated by a fall-back transition, is resolved.
+type OverlapPolicy int
+
+// Overlap policies
+const (
+	// OverlapEarlier takes the first occurrence, before the transition.
+	OverlapEarlier OverlapPolicy = iota
+
+	// OverlapLater takes the second occurrence, after the transition.
+	OverlapLater
+)
+
+// Policy determines how wall clock times around DST transitions
+// are resolved. The zero value shifts times in a gap forward and
+// takes the earlier of ambiguous times.
+type Policy struct {
+	Gap     GapPolicy
+	Overlap OverlapPolicy
+}
+
+// NextOccurrence returns the next instant after after at which the
+// wall clock in the zone passed reads clock, resolving times around
+// DST transitions with the zero Policy.
+func NextOccurrence(zone string, clock Clock, after time.Time) (time.Time, error) {
+	return Policy{}.NextOccurrence(zone, clock, after)
+}
+
+// NextOccurrence returns the next instant after after at which the
+// wall clock in the zone passed reads clock, resolving times around
+// DST transitions per p.
+func (p Policy) NextOccurrence(zone string, clock Clock, after time.Time) (time.Time, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	local := after.In(loc)
+
+	// at most one day is rejected in a row, so three suffice.
+	for day := 0; day < 3; day++ {
+		wall := time.Date(local.Year(), local.Month(), local.Day()+day, clock.Hour, clock.Minute, clock.Second, 0, time.UTC)
+
+		t, _, err := p.resolve(loc, wall)
+		if err != nil {
+			continue
+		}
+		if t.After(after) {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("tz: no occurrence of %02d:%02d:%02d in %s", clock.Hour, clock.Minute, clock.Second, zone)
+}
+
+// Resolution reports how a wall clock time was resolved to an instant.
+type Resolution int
+
+// Resolutions
+const (
+	// Unique is a wall clock time occurring exactly once.
+	Unique Resolution = iota
+
+	// Ambiguous is a wall clock time occurring twice, repeated
+	// by a fall-back transition, the Policy choosing which.
+	Ambiguous
+
+	// Skipped is a wall clock time not occurring at all, skipped
+	// by a spring-forward transition, the Policy choosing the
+	// instant instead.
+	Skipped
+)
+
+// String returns the name of the Resolution, eg. "ambiguous".
+func (r Resolution) String() string {
+	switch r {
+	case Unique:
+		return "unique"
+	case Ambiguous:
+		return "ambiguous"
+	case Skipped:
+		return "skipped"
+	default:
+		return "Resolution(" + strconv.Itoa(int(r)) + ")"
+	}
+}
+
+// ResolveLocal returns the instant the wall clock in the zone passed
+// reads the local date and time passed, per policy, reporting whether
+// that local time was unique, ambiguous or skipped, eg. for warning
+// users their chosen time doesn't exist. With GapReject skipped times
+// return an error.
+func ResolveLocal(zone string, y, m, d, hh, mm int, policy Policy) (time.Time, Resolution, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, Unique, err
+	}
+
+	return policy.resolve(loc, time.Date(y, time.Month(m), d, hh, mm, 0, 0, time.UTC))
+}
+
+// resolve returns the instant the wall clock time of wall, in UTC,
+// is in loc per p, along with its Resolution. Assumes at most one
+// transition within a day of wall.
+func (p Policy) resolve(loc *time.Location, wall time.Time) (time.Time, Resolution, error) {
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	tBefore := wall.Add(-time.Duration(before) * time.Second).In(loc)
+	tAfter := wall.Add(-time.Duration(after) * time.Second).In(loc)
+
+	_, offBefore := tBefore.Zone()
+	_, offAfter := tAfter.Zone()
+
+	validBefore := offBefore == before
+	validAfter := offAfter == after
+
+	switch {
+	case validBefore && validAfter && !tBefore.Equal(tAfter):
+		if p.Overlap == OverlapLater {
+			return latest(tBefore, tAfter), Ambiguous, nil
+		}
+		return earliest(tBefore, tAfter), Ambiguous, nil
+	case validBefore:
+		return tBefore, Unique, nil
+	case validAfter:
+		return tAfter, Unique, nil
+	}
+
+	switch p.Gap {
+	case GapNext:
+		start, _ := tBefore.ZoneBounds()
+		return start, Skipped, nil
+	case GapReject:
+		return time.Time{}, Skipped, fmt.Errorf("tz: %s does not exist in %s", wall.Format("2006-01-02 15:04:05"), loc)
+	default:
+		// the offset before the gap lands past it,
+		// by the length of the gap.
+		return tBefore, Skipped, nil
+	}
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
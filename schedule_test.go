@@ -0,0 +1,154 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveLocal(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    string
+		wall    [5]int
+		policy  Policy
+		want    time.Time
+		res     Resolution
+		wantErr bool
+	}{
+		{
+			name: "unique",
+			zone: "America/New_York",
+			wall: [5]int{2024, 3, 10, 12, 0},
+			want: time.Date(2024, time.March, 10, 16, 0, 0, 0, time.UTC),
+			res:  Unique,
+		},
+		{
+			name:   "spring forward gap shift",
+			zone:   "America/New_York",
+			wall:   [5]int{2024, 3, 10, 2, 30},
+			policy: Policy{Gap: GapShift},
+			want:   time.Date(2024, time.March, 10, 7, 30, 0, 0, time.UTC),
+			res:    Skipped,
+		},
+		{
+			name:   "spring forward gap next",
+			zone:   "America/New_York",
+			wall:   [5]int{2024, 3, 10, 2, 30},
+			policy: Policy{Gap: GapNext},
+			want:   time.Date(2024, time.March, 10, 7, 0, 0, 0, time.UTC),
+			res:    Skipped,
+		},
+		{
+			name:    "spring forward gap reject",
+			zone:    "America/New_York",
+			wall:    [5]int{2024, 3, 10, 2, 30},
+			policy:  Policy{Gap: GapReject},
+			res:     Skipped,
+			wantErr: true,
+		},
+		{
+			name:   "fall back overlap earlier",
+			zone:   "America/New_York",
+			wall:   [5]int{2024, 11, 3, 1, 30},
+			policy: Policy{Overlap: OverlapEarlier},
+			want:   time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC),
+			res:    Ambiguous,
+		},
+		{
+			name:   "fall back overlap later",
+			zone:   "America/New_York",
+			wall:   [5]int{2024, 11, 3, 1, 30},
+			policy: Policy{Overlap: OverlapLater},
+			want:   time.Date(2024, time.November, 3, 6, 30, 0, 0, time.UTC),
+			res:    Ambiguous,
+		},
+		// Lord Howe Island shifts by 30 minutes, +10:30 to +11.
+		{
+			name:   "half hour gap shift",
+			zone:   "Australia/Lord_Howe",
+			wall:   [5]int{2024, 10, 6, 2, 15},
+			policy: Policy{Gap: GapShift},
+			want:   time.Date(2024, time.October, 5, 15, 45, 0, 0, time.UTC),
+			res:    Skipped,
+		},
+		{
+			name:   "half hour gap next",
+			zone:   "Australia/Lord_Howe",
+			wall:   [5]int{2024, 10, 6, 2, 15},
+			policy: Policy{Gap: GapNext},
+			want:   time.Date(2024, time.October, 5, 15, 30, 0, 0, time.UTC),
+			res:    Skipped,
+		},
+		{
+			name:    "half hour gap reject",
+			zone:    "Australia/Lord_Howe",
+			wall:    [5]int{2024, 10, 6, 2, 15},
+			policy:  Policy{Gap: GapReject},
+			res:     Skipped,
+			wantErr: true,
+		},
+		{
+			name:   "half hour overlap earlier",
+			zone:   "Australia/Lord_Howe",
+			wall:   [5]int{2024, 4, 7, 1, 45},
+			policy: Policy{Overlap: OverlapEarlier},
+			want:   time.Date(2024, time.April, 6, 14, 45, 0, 0, time.UTC),
+			res:    Ambiguous,
+		},
+		{
+			name:   "half hour overlap later",
+			zone:   "Australia/Lord_Howe",
+			wall:   [5]int{2024, 4, 7, 1, 45},
+			policy: Policy{Overlap: OverlapLater},
+			want:   time.Date(2024, time.April, 6, 15, 15, 0, 0, time.UTC),
+			res:    Ambiguous,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, res, err := ResolveLocal(tt.zone, tt.wall[0], tt.wall[1], tt.wall[2], tt.wall[3], tt.wall[4], tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if res != tt.res {
+				t.Errorf("got resolution %s, want %s", res, tt.res)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	after := time.Date(2024, time.March, 10, 5, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   time.Time
+	}{
+		{
+			name: "gap shift",
+			want: time.Date(2024, time.March, 10, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "gap reject skips the day",
+			policy: Policy{Gap: GapReject},
+			want:   time.Date(2024, time.March, 11, 6, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.policy.NextOccurrence("America/New_York", Clock{Hour: 2, Minute: 30}, after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package tz
+
+import (
+	"strings"
+	"unicode"
+)
+
+// searchIndex and synonymIndex contain the normalized Country
+// names and Synonyms, in the same order as countries.
+var (
+	searchIndex  []string
+	synonymIndex [][]string
+)
+
+// indexSearch indexes the normalized names for below search functions.
+func indexSearch() {
+	searchIndex = make([]string, len(countries))
+	synonymIndex = make([][]string, len(countries))
+
+	for i := 0; i < len(countries); i++ {
+		searchIndex[i] = NormalizeCountryName(countries[i].Name)
+
+		for _, s := range countries[i].Synonyms {
+			synonymIndex[i] = append(synonymIndex[i], NormalizeCountryName(s))
+		}
+	}
+}
+
+// CountryMatch is a Country matched by MatchCountries,
+// along with the synonym matched, if any.
+type CountryMatch struct {
+	Country Country
+
+	// Synonym is the one of the Country's Synonyms matched,
+	// empty when its name or code matched.
+	Synonym string
+}
+
+// SearchCountries returns all countries whose name or one of whose
+// Synonyms contains query, or whose code equals query. Matching ignores
+// case and diacritics so "Cote d'Ivoire" matches "Côte d'Ivoire".
+func SearchCountries(query string) []Country {
+	matches := MatchCountries(query)
+	if matches == nil {
+		return nil
+	}
+
+	results := make([]Country, len(matches))
+	for i, m := range matches {
+		results[i] = m.Country
+	}
+	return results
+}
+
+// MatchCountries returns the countries matched as by SearchCountries,
+// reporting the synonym matched, eg. "Burma" for a query of "burm".
+func MatchCountries(query string) []CountryMatch {
+	load()
+
+	q := NormalizeCountryName(query)
+	if q == "" {
+		return nil
+	}
+
+	var results []CountryMatch
+
+	for i := 0; i < len(countries); i++ {
+		if strings.Contains(searchIndex[i], q) || strings.EqualFold(countries[i].Code, query) {
+			results = append(results, CountryMatch{Country: countries[i]})
+			continue
+		}
+
+		for j, s := range synonymIndex[i] {
+			if strings.Contains(s, q) {
+				results = append(results, CountryMatch{Country: countries[i], Synonym: countries[i].Synonyms[j]})
+				break
+			}
+		}
+	}
+
+	return results
+}
+
+// NormalizeCountryName returns s in lower case, accented latin letters
+// folded to ASCII, whether composed or decomposed, with
+// apostrophes removed, other punctuation and whitespace collapsed to
+// single spaces and a leading "the" removed, eg. "bahamas" for
+// "The Bahamas" and "cote divoire" for "Côte d'Ivoire". Searching
+// compares names normalized so, callers may normalize their own data
+// to match alike.
+func NormalizeCountryName(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	space := false
+	latin := false
+
+	for _, r := range strings.ToLower(fold(s)) {
+		switch {
+		case r == '\'' || r == '’':
+			continue
+		case unicode.Is(unicode.Cf, r):
+			// eg. the zero width joiners of Sinhala.
+			continue
+		case unicode.Is(unicode.Mn, r) && latin:
+			// the accents of decomposed latin letters, folded
+			// alike those of composed ones.
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			latin = unicode.Is(unicode.Latin, r)
+			b.WriteRune(r)
+		default:
+			space = true
+		}
+	}
+
+	return strings.TrimPrefix(b.String(), "the ")
+}
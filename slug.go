@@ -0,0 +1,134 @@
+package tz
+
+import (
+	"strings"
+	"unicode"
+)
+
+var (
+	countrySlugs map[string]int
+	zoneSlugs    map[string]Zone
+	aliasSlugs   map[string]Zone
+)
+
+// indexSlugs indexes the slugs for below lookup functions.
+func indexSlugs() {
+	countrySlugs = make(map[string]int, len(countries))
+	zoneSlugs = make(map[string]Zone)
+
+	for i := 0; i < len(countries); i++ {
+		countrySlugs[countries[i].Slug()] = i
+
+		for _, z := range countries[i].Zones {
+			zoneSlugs[z.Slug()] = z
+		}
+	}
+
+	aliasSlugs = make(map[string]Zone, len(zoneAliases))
+
+	for alias, name := range zoneAliases {
+		if z, ok := zones[name]; ok {
+			aliasSlugs[slugify(alias)] = z
+		}
+	}
+}
+
+// Slug returns a URL safe representation of the Country name
+// eg. "Côte d'Ivoire" becomes "cote-divoire".
+func (c Country) Slug() string {
+	return slugify(c.Name)
+}
+
+// Slug returns a URL safe representation of the Zone name
+// eg. "America/New_York" becomes "america-new-york".
+func (z Zone) Slug() string {
+	return slugify(z.Name)
+}
+
+// GetCountryBySlug returns a single Country that matches the slug
+// passed, as returned by Country.Slug, and whether it was found
+func GetCountryBySlug(slug string) (c Country, found bool) {
+	load()
+	idx, found := countrySlugs[slug]
+	if !found {
+		return
+	}
+	return countries[idx], true
+}
+
+// GetZoneBySlug returns a single Zone that matches the slug
+// passed, as returned by Zone.Slug, and whether it was found
+func GetZoneBySlug(slug string) (z Zone, found bool) {
+	load()
+	z, found = zoneSlugs[slug]
+	return
+}
+
+// slugify lower cases and folds s to ASCII, dropping apostrophes
+// and collapsing all other non alphanumeric runs into a single dash.
+func slugify(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	dash := false
+
+	for _, r := range fold(s) {
+		switch {
+		case r == '\'':
+			continue
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			dash = true
+		}
+	}
+
+	return b.String()
+}
+
+// foldings contains the ASCII replacements for the accented
+// latin letters found in country and zone names.
+var foldings = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE",
+	'Ç': "C", 'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I",
+	'Î': "I", 'Ï': "I", 'Ð': "D", 'Ñ': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O",
+	'Õ': "O", 'Ö': "O", 'Ø': "O", 'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U",
+	'Ý': "Y", 'Þ': "TH", 'ß': "ss", 'à': "a", 'á': "a", 'â': "a", 'ã': "a",
+	'ä': "a", 'å': "a", 'æ': "ae", 'ç': "c", 'è': "e", 'é': "e", 'ê': "e",
+	'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d", 'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u",
+	'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y",
+	'Ā': "A", 'ā': "a", 'Ă': "A", 'ă': "a", 'Ą': "A", 'ą': "a", 'Ć': "C",
+	'ć': "c", 'Č': "C", 'č': "c", 'Ď': "D", 'ď': "d", 'Đ': "D", 'đ': "d",
+	'Ē': "E", 'ē': "e", 'Ė': "E", 'ė': "e", 'Ę': "E", 'ę': "e", 'Ě': "E",
+	'ě': "e", 'Ğ': "G", 'ğ': "g", 'Ī': "I", 'ī': "i", 'Į': "I", 'į': "i",
+	'İ': "I", 'ı': "i", 'Ķ': "K", 'ķ': "k", 'Ļ': "L", 'ļ': "l", 'Ł': "L",
+	'ł': "l", 'Ń': "N", 'ń': "n", 'Ņ': "N", 'ņ': "n", 'Ň': "N", 'ň': "n",
+	'Ō': "O", 'ō': "o", 'Ő': "O", 'ő': "o", 'Œ': "OE", 'œ': "oe", 'Ř': "R",
+	'ř': "r", 'Ś': "S", 'ś': "s", 'Ş': "S", 'ş': "s", 'Š': "S", 'š': "s",
+	'Ţ': "T", 'ţ': "t", 'Ť': "T", 'ť': "t", 'Ū': "U", 'ū': "u", 'Ů': "U",
+	'ů': "u", 'Ű': "U", 'ű': "u", 'Ų': "U", 'ų': "u", 'Ÿ': "Y", 'Ź': "Z",
+	'ź': "z", 'Ż': "Z", 'ż': "z", 'Ž': "Z", 'ž': "z", 'Ș': "S", 'ș': "s",
+	'Ț': "T", 'ț': "t", '’': "'", '‘': "'",
+}
+
+// fold replaces the accented latin letters in s with
+// their ASCII equivalents.
+func fold(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		if f, ok := foldings[r]; ok {
+			b.WriteString(f)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
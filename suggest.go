@@ -0,0 +1,148 @@
+package tz
+
+import (
+	"sort"
+	"strings"
+)
+
+// suggestion is a candidate name and its edit distance from the input.
+type suggestion struct {
+	name string
+	dist int
+}
+
+// SuggestZones returns the names of up to n zones closest to input,
+// nearest first, for offering corrections as the user types, eg.
+// "America/Sao_Paulo" for "sao paulo" or "Amrica/Sao". Input is
+// compared against the zone names, deprecated names included, and
+// their city part, ignoring case and punctuation.
+func SuggestZones(input string, n int) []string {
+	load()
+	return suggestZones(input, n)
+}
+
+// SuggestCountries returns up to n countries whose name or code is
+// closest to input, nearest first, for offering corrections as the
+// user types, eg. Germany for "Grmany" or "germ". Matching ignores
+// case and diacritics.
+func SuggestCountries(input string, n int) []Country {
+	load()
+
+	q := NormalizeCountryName(input)
+	if q == "" || n <= 0 {
+		return nil
+	}
+
+	best := make(map[string]int, len(countries))
+
+	for i := 0; i < len(countries); i++ {
+		best[countries[i].Code] = min(distance(q, searchIndex[i]), levenshtein(q, strings.ToLower(countries[i].Code)))
+	}
+
+	codes := closest(q, best, n)
+
+	cs := make([]Country, len(codes))
+	for i, code := range codes {
+		cs[i] = mapped[code]
+	}
+	return cs
+}
+
+// suggestZones returns the names of up to n zones closest to input,
+// comparing against both the full zone names, deprecated names
+// included, and their city part, nearest first.
+func suggestZones(input string, n int) []string {
+	s := slugify(input)
+	if s == "" || n <= 0 {
+		return nil
+	}
+
+	best := make(map[string]int)
+
+	consider := func(slug string, z Zone) {
+		d := distance(s, slug)
+		if prev, ok := best[z.Name]; !ok || d < prev {
+			best[z.Name] = d
+		}
+	}
+
+	for slug, z := range zoneSlugs {
+		consider(slug, z)
+		consider(slugify(z.Name[strings.LastIndexByte(z.Name, '/')+1:]), z)
+	}
+
+	for slug, z := range aliasSlugs {
+		consider(slug, z)
+	}
+
+	return closest(s, best, n)
+}
+
+// closest returns up to n of the names whose distance is within
+// about a third of the length of the input s, nearest first.
+func closest(s string, dists map[string]int, n int) []string {
+	limit := (len(s) + 1) / 3
+
+	var matches []suggestion
+	for name, d := range dists {
+		if d <= limit {
+			matches = append(matches, suggestion{name: name, dist: d})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return matches[i].dist < matches[j].dist
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// distance returns the edit distance between s and candidate,
+// or its prefixes around the length of s when closer, so
+// partially typed input matches.
+func distance(s, candidate string) int {
+	d := levenshtein(s, candidate)
+	for k := len(s) - 1; k <= len(s)+1 && k < len(candidate); k++ {
+		if k > 0 {
+			d = min(d, levenshtein(s, candidate[:k]))
+		}
+	}
+	return d
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,77 @@
+package tz
+
+import (
+	"sync"
+	"time"
+)
+
+// DailyTicker delivers, once a day, the instant at which the wall
+// clock in a zone reads a Clock, re-arming for each day so it keeps
+// firing at the same local time across DST transitions.
+type DailyTicker struct {
+	C <-chan time.Time
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewDailyTicker returns a DailyTicker firing every day at clock in
+// the zone passed, resolving times around DST transitions with the
+// zero Policy.
+func NewDailyTicker(zone string, clock Clock) (*DailyTicker, error) {
+	return Policy{}.NewDailyTicker(zone, clock)
+}
+
+// NewDailyTicker returns a DailyTicker firing every day at clock in
+// the zone passed, resolving times around DST transitions per p.
+// As with time.Ticker, ticks are dropped for slow receivers.
+func (p Policy) NewDailyTicker(zone string, clock Clock) (*DailyTicker, error) {
+	next, err := p.NextOccurrence(zone, clock, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(chan time.Time, 1)
+	t := &DailyTicker{
+		C:    c,
+		stop: make(chan struct{}),
+	}
+
+	go func() {
+		timer := time.NewTimer(time.Until(next))
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-t.stop:
+				return
+			case <-timer.C:
+			}
+
+			select {
+			case c <- next:
+			default:
+			}
+
+			// the zone loaded above, so this can't fail
+			// short of the zone rejecting days in a row.
+			if following, err := p.NextOccurrence(zone, clock, next); err == nil {
+				next = following
+			} else {
+				next = next.Add(24 * time.Hour)
+			}
+
+			timer.Reset(time.Until(next))
+		}
+	}()
+
+	return t, nil
+}
+
+// Stop turns off the ticker, after which no more ticks are sent.
+// It does not close C.
+func (t *DailyTicker) Stop() {
+	t.once.Do(func() {
+		close(t.stop)
+	})
+}
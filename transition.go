@@ -0,0 +1,158 @@
+package tz
+
+import (
+	"fmt"
+	"time"
+)
+
+// Transition is a change of a zone's offset or abbreviation,
+// eg. the start or end of DST.
+type Transition struct {
+	Zone string
+	At   time.Time
+
+	// Before and After are the offsets in effect before and from At.
+	Before, After Offset
+
+	// BeforeName and AfterName are the abbreviations
+	// in effect before and from At, eg. "EST" and "EDT".
+	BeforeName, AfterName string
+}
+
+// Transitions returns the transitions of the zone name passed
+// from from until to, oldest first.
+func Transitions(zone string, from, to time.Time) ([]Transition, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	var ts []Transition
+
+	t := from.In(loc)
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || !end.Before(to) {
+			break
+		}
+
+		next := end.In(loc)
+
+		if tr := transition(zone, t, next); tr.changes() {
+			ts = append(ts, tr)
+		}
+
+		t = next
+	}
+
+	return ts, nil
+}
+
+// UntilNextTransition returns the time from from until the next
+// transition of the zone name passed, along with that transition,
+// eg. for answering how long until its clocks change. Zones without
+// transitions after from return an error.
+func UntilNextTransition(zone string, from time.Time) (time.Duration, Transition, error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return 0, Transition{}, err
+	}
+
+	t := from.In(loc)
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() {
+			return 0, Transition{}, fmt.Errorf("tz: no transition of %s after %s", zone, from.Format(time.RFC3339))
+		}
+
+		next := end.In(loc)
+
+		if tr := transition(zone, t, next); tr.changes() {
+			return end.Sub(from), tr, nil
+		}
+
+		t = next
+	}
+}
+
+// transition returns the Transition of zone at next,
+// from the offset and abbreviation in effect at t.
+func transition(zone string, t, next time.Time) Transition {
+	beforeName, _ := t.Zone()
+	afterName, _ := next.Zone()
+
+	return Transition{
+		Zone:       zone,
+		At:         next.UTC(),
+		Before:     OffsetOf(t),
+		After:      OffsetOf(next),
+		BeforeName: beforeName,
+		AfterName:  afterName,
+	}
+}
+
+// changes returns whether tr changes the offset or abbreviation,
+// zone bounds not always doing so.
+func (tr Transition) changes() bool {
+	return tr.Before != tr.After || tr.BeforeName != tr.AfterName
+}
+
+// OffsetRange returns the smallest and largest offsets the zone name
+// passed is at during year, eg. -8h and -7h for America/Los_Angeles,
+// for validating stored offsets or rendering "UTC-08:00/-07:00".
+func OffsetRange(zone string, year int) (min, max time.Duration, err error) {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+
+	ts, err := Transitions(zone, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	min = OffsetOf(from).Duration()
+	max = min
+
+	for _, t := range ts {
+		d := t.After.Duration()
+		if d < min {
+			min = d
+		}
+		if d > max {
+			max = d
+		}
+	}
+
+	return min, max, nil
+}
+
+// DatasetOffsetRange returns the smallest and largest offsets any
+// zone is currently at, eg. -11h and +14h, for bounding sliders and
+// validation without hardcoding them.
+func DatasetOffsetRange() (min, max time.Duration) {
+	load()
+
+	now := time.Now()
+	first := true
+
+	for _, z := range zonesList {
+		loc, err := LoadLocation(z.Name)
+		if err != nil {
+			continue
+		}
+
+		d := OffsetOf(now.In(loc)).Duration()
+		if first || d < min {
+			min = d
+		}
+		if first || d > max {
+			max = d
+		}
+		first = false
+	}
+
+	return min, max
+}
@@ -1,14 +1,122 @@
 package tz
 
+import (
+	"strings"
+)
+
 // Zone contains a single Country's Zone information
 type Zone struct {
 	CountryCode string
 	Name        string
+
+	// Common is set for the curated subset of zones
+	// returned by CommonZones.
+	Common bool
+
+	// Deprecated is set for the backward-compatibility zone
+	// names, eg. "US/Eastern", included in the data when it
+	// was generated with -backward.
+	Deprecated bool
+
+	// HistoricalAccuracy is how accurate the zone's data is
+	// before 1970, only known for data generated with -backward.
+	HistoricalAccuracy HistoricalAccuracy
 }
 
-// Country contains a single Country's information
+// HistoricalAccuracy is how accurate a zone's data is before 1970.
+type HistoricalAccuracy string
+
+// Historical accuracies
+const (
+	// HistoricalUnknown is the accuracy of the zones of data
+	// generated without -backward.
+	HistoricalUnknown HistoricalAccuracy = ""
+
+	// HistoricalAccurate zones have data accurate before 1970 too,
+	// being tzdb zones rather than links, or zones of backzone.
+	HistoricalAccurate HistoricalAccuracy = "accurate"
+
+	// HistoricalSince1970 zones link to zones merged with theirs
+	// since 1970, so have data only accurate since.
+	HistoricalSince1970 HistoricalAccuracy = "since-1970"
+)
+
+// Country contains a single Country's information.
+// The Countries returned by the package share their Zones and
+// Synonyms with the package data, which must not be modified.
 type Country struct {
-	Code  string
-	Name  string
-	Zones []Zone
+	Code string
+	Name string
+
+	// Ordinal is a stable number identifying the Country,
+	// kept across data releases and never reused.
+	Ordinal int
+
+	// Synonyms contains common and former English names of the
+	// Country, eg. "Burma" for Myanmar, matched by SearchCountries.
+	Synonyms []string
+
+	// UserAssigned is set when Code is a user-assigned rather
+	// than an official ISO 3166-1 code, eg. XK for Kosovo.
+	UserAssigned bool
+	Zones        []Zone
+}
+
+// cityNames contains the city segments of zone names that
+// don't read correctly by simply replacing underscores.
+var cityNames = map[string]string{
+	"DumontDUrville": "Dumont d'Urville",
+	"Ho_Chi_Minh":    "Ho Chi Minh City",
+	"Lower_Princes":  "Lower Prince's Quarter",
+	"St_Johns":       "St. John's",
+}
+
+// DisplayName returns a human friendly name for the zone
+// eg. "America/New_York" becomes "New York (America)" and
+// "America/Argentina/Buenos_Aires" becomes
+// "Buenos Aires, Argentina (America)", unless overridden with
+// OverrideZoneDisplayName.
+func (z Zone) DisplayName() string {
+	if name, ok := zoneDisplayNames[z.Name]; ok {
+		return name
+	}
+
+	parts := strings.Split(z.Name, "/")
+	if len(parts) == 1 {
+		return cityName(parts[0])
+	}
+
+	name := cityName(parts[len(parts)-1])
+
+	for i := len(parts) - 2; i > 0; i-- {
+		name += ", " + cityName(parts[i])
+	}
+
+	return name + " (" + parts[0] + ")"
+}
+
+// cityName returns the human friendly form of a single
+// zone name segment.
+func cityName(s string) string {
+	if name, ok := cityNames[s]; ok {
+		return name
+	}
+
+	if strings.HasPrefix(s, "St_") {
+		s = "St. " + s[3:]
+	}
+
+	return strings.ReplaceAll(s, "_", " ")
+}
+
+// Attribution returns the attribution required by the license
+// of the data source the compiled in data was generated from,
+// for display in applications using the data.
+func Attribution() string {
+	return dataAttribution
+}
+
+// License returns the license of the compiled in data.
+func License() string {
+	return dataLicense
 }
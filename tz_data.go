@@ -1,3227 +1,3843 @@
-package tz
+//go:build tz_literal
 
-import "sync"
+package tz
 
 // GENERATED FILE DO NOT MODIFY DIRECTLY
 
-var (
-	once      sync.Once
-	mapped    map[string]Country
-	countries = []Country{
-		{
-			Code: "AF",
-			Name: "Afghanistan",
-			Zones: []Zone{
-				{
-					CountryCode: "AF",
-					Name:        "Asia/Kabul",
-				},
-			},
-		},
-		{
-			Code: "AL",
-			Name: "Albania",
-			Zones: []Zone{
-				{
-					CountryCode: "AL",
-					Name:        "Europe/Tirane",
-				},
-			},
-		},
-		{
-			Code: "DZ",
-			Name: "Algeria",
-			Zones: []Zone{
-				{
-					CountryCode: "DZ",
-					Name:        "Africa/Algiers",
-				},
-			},
-		},
-		{
-			Code: "AS",
-			Name: "American Samoa",
-			Zones: []Zone{
-				{
-					CountryCode: "AS",
-					Name:        "Pacific/Pago_Pago",
-				},
-			},
-		},
-		{
-			Code: "AD",
-			Name: "Andorra",
-			Zones: []Zone{
-				{
-					CountryCode: "AD",
-					Name:        "Europe/Andorra",
-				},
-			},
-		},
-		{
-			Code: "AO",
-			Name: "Angola",
-			Zones: []Zone{
-				{
-					CountryCode: "AO",
-					Name:        "Africa/Luanda",
-				},
-			},
-		},
-		{
-			Code: "AI",
-			Name: "Anguilla",
-			Zones: []Zone{
-				{
-					CountryCode: "AI",
-					Name:        "America/Anguilla",
-				},
-			},
-		},
-		{
-			Code: "AQ",
-			Name: "Antarctica",
-			Zones: []Zone{
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Casey",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Davis",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/DumontDUrville",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Mawson",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/McMurdo",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Palmer",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Rothera",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Syowa",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Troll",
-				},
-				{
-					CountryCode: "AQ",
-					Name:        "Antarctica/Vostok",
-				},
-			},
-		},
-		{
-			Code: "AG",
-			Name: "Antigua and Barbuda",
-			Zones: []Zone{
-				{
-					CountryCode: "AG",
-					Name:        "America/Antigua",
-				},
-			},
-		},
-		{
-			Code: "AR",
-			Name: "Argentina",
-			Zones: []Zone{
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Buenos_Aires",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Catamarca",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Cordoba",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Jujuy",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/La_Rioja",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Mendoza",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Rio_Gallegos",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Salta",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/San_Juan",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/San_Luis",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Tucuman",
-				},
-				{
-					CountryCode: "AR",
-					Name:        "America/Argentina/Ushuaia",
-				},
-			},
-		},
-		{
-			Code: "AM",
-			Name: "Armenia",
-			Zones: []Zone{
-				{
-					CountryCode: "AM",
-					Name:        "Asia/Yerevan",
-				},
-			},
-		},
-		{
-			Code: "AW",
-			Name: "Aruba",
-			Zones: []Zone{
-				{
-					CountryCode: "AW",
-					Name:        "America/Aruba",
-				},
-			},
-		},
-		{
-			Code: "AU",
-			Name: "Australia",
-			Zones: []Zone{
-				{
-					CountryCode: "AU",
-					Name:        "Antarctica/Macquarie",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Adelaide",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Brisbane",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Broken_Hill",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Darwin",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Eucla",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Hobart",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Lindeman",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Lord_Howe",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Melbourne",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Perth",
-				},
-				{
-					CountryCode: "AU",
-					Name:        "Australia/Sydney",
-				},
-			},
-		},
-		{
-			Code: "AT",
-			Name: "Austria",
-			Zones: []Zone{
-				{
-					CountryCode: "AT",
-					Name:        "Europe/Vienna",
-				},
-			},
-		},
-		{
-			Code: "AZ",
-			Name: "Azerbaijan",
-			Zones: []Zone{
-				{
-					CountryCode: "AZ",
-					Name:        "Asia/Baku",
-				},
-			},
-		},
-		{
-			Code: "BS",
-			Name: "Bahamas",
-			Zones: []Zone{
-				{
-					CountryCode: "BS",
-					Name:        "America/Nassau",
-				},
-			},
-		},
-		{
-			Code: "BH",
-			Name: "Bahrain",
-			Zones: []Zone{
-				{
-					CountryCode: "BH",
-					Name:        "Asia/Bahrain",
-				},
-			},
-		},
-		{
-			Code: "BD",
-			Name: "Bangladesh",
-			Zones: []Zone{
-				{
-					CountryCode: "BD",
-					Name:        "Asia/Dhaka",
-				},
-			},
-		},
-		{
-			Code: "BB",
-			Name: "Barbados",
-			Zones: []Zone{
-				{
-					CountryCode: "BB",
-					Name:        "America/Barbados",
-				},
-			},
-		},
-		{
-			Code: "BY",
-			Name: "Belarus",
-			Zones: []Zone{
-				{
-					CountryCode: "BY",
-					Name:        "Europe/Minsk",
-				},
-			},
-		},
-		{
-			Code: "BE",
-			Name: "Belgium",
-			Zones: []Zone{
-				{
-					CountryCode: "BE",
-					Name:        "Europe/Brussels",
-				},
-			},
-		},
-		{
-			Code: "BZ",
-			Name: "Belize",
-			Zones: []Zone{
-				{
-					CountryCode: "BZ",
-					Name:        "America/Belize",
-				},
-			},
-		},
-		{
-			Code: "BJ",
-			Name: "Benin",
-			Zones: []Zone{
-				{
-					CountryCode: "BJ",
-					Name:        "Africa/Porto-Novo",
-				},
-			},
-		},
-		{
-			Code: "BM",
-			Name: "Bermuda",
-			Zones: []Zone{
-				{
-					CountryCode: "BM",
-					Name:        "Atlantic/Bermuda",
-				},
-			},
-		},
-		{
-			Code: "BT",
-			Name: "Bhutan",
-			Zones: []Zone{
-				{
-					CountryCode: "BT",
-					Name:        "Asia/Thimphu",
-				},
-			},
-		},
-		{
-			Code: "BO",
-			Name: "Bolivia (Plurinational State of)",
-			Zones: []Zone{
-				{
-					CountryCode: "BO",
-					Name:        "America/La_Paz",
-				},
-			},
-		},
-		{
-			Code: "BQ",
-			Name: "Bonaire, Sint Eustatius and Saba",
-			Zones: []Zone{
-				{
-					CountryCode: "BQ",
-					Name:        "America/Kralendijk",
-				},
-			},
-		},
-		{
-			Code: "BA",
-			Name: "Bosnia and Herzegovina",
-			Zones: []Zone{
-				{
-					CountryCode: "BA",
-					Name:        "Europe/Sarajevo",
-				},
-			},
-		},
-		{
-			Code: "BW",
-			Name: "Botswana",
-			Zones: []Zone{
-				{
-					CountryCode: "BW",
-					Name:        "Africa/Gaborone",
-				},
-			},
-		},
-		{
-			Code:  "BV",
-			Name:  "Bouvet Island",
-			Zones: []Zone{},
-		},
-		{
-			Code: "BR",
-			Name: "Brazil",
-			Zones: []Zone{
-				{
-					CountryCode: "BR",
-					Name:        "America/Araguaina",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Bahia",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Belem",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Boa_Vista",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Campo_Grande",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Cuiaba",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Eirunepe",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Fortaleza",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Maceio",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Manaus",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Noronha",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Porto_Velho",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Recife",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Rio_Branco",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Santarem",
-				},
-				{
-					CountryCode: "BR",
-					Name:        "America/Sao_Paulo",
-				},
-			},
-		},
-		{
-			Code: "IO",
-			Name: "British Indian Ocean Territory",
-			Zones: []Zone{
-				{
-					CountryCode: "IO",
-					Name:        "Indian/Chagos",
-				},
-			},
-		},
-		{
-			Code: "BN",
-			Name: "Brunei Darussalam",
-			Zones: []Zone{
-				{
-					CountryCode: "BN",
-					Name:        "Asia/Brunei",
-				},
-			},
-		},
-		{
-			Code: "BG",
-			Name: "Bulgaria",
-			Zones: []Zone{
-				{
-					CountryCode: "BG",
-					Name:        "Europe/Sofia",
-				},
-			},
-		},
-		{
-			Code: "BF",
-			Name: "Burkina Faso",
-			Zones: []Zone{
-				{
-					CountryCode: "BF",
-					Name:        "Africa/Ouagadougou",
-				},
-			},
-		},
-		{
-			Code: "BI",
-			Name: "Burundi",
-			Zones: []Zone{
-				{
-					CountryCode: "BI",
-					Name:        "Africa/Bujumbura",
-				},
-			},
-		},
-		{
-			Code: "CV",
-			Name: "Cabo Verde",
-			Zones: []Zone{
-				{
-					CountryCode: "CV",
-					Name:        "Atlantic/Cape_Verde",
-				},
-			},
-		},
-		{
-			Code: "KH",
-			Name: "Cambodia",
-			Zones: []Zone{
-				{
-					CountryCode: "KH",
-					Name:        "Asia/Phnom_Penh",
-				},
-			},
-		},
-		{
-			Code: "CM",
-			Name: "Cameroon",
-			Zones: []Zone{
-				{
-					CountryCode: "CM",
-					Name:        "Africa/Douala",
-				},
-			},
-		},
-		{
-			Code: "CA",
-			Name: "Canada",
-			Zones: []Zone{
-				{
-					CountryCode: "CA",
-					Name:        "America/Atikokan",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Blanc-Sablon",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Cambridge_Bay",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Creston",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Dawson",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Dawson_Creek",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Edmonton",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Fort_Nelson",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Glace_Bay",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Goose_Bay",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Halifax",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Inuvik",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Iqaluit",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Moncton",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Nipigon",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Pangnirtung",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Rainy_River",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Rankin_Inlet",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Regina",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Resolute",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/St_Johns",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Swift_Current",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Thunder_Bay",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Toronto",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Vancouver",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Whitehorse",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Winnipeg",
-				},
-				{
-					CountryCode: "CA",
-					Name:        "America/Yellowknife",
-				},
-			},
-		},
-		{
-			Code: "KY",
-			Name: "Cayman Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "KY",
-					Name:        "America/Cayman",
-				},
-			},
-		},
-		{
-			Code: "CF",
-			Name: "Central African Republic",
-			Zones: []Zone{
-				{
-					CountryCode: "CF",
-					Name:        "Africa/Bangui",
-				},
-			},
-		},
-		{
-			Code: "TD",
-			Name: "Chad",
-			Zones: []Zone{
-				{
-					CountryCode: "TD",
-					Name:        "Africa/Ndjamena",
-				},
-			},
-		},
-		{
-			Code: "CL",
-			Name: "Chile",
-			Zones: []Zone{
-				{
-					CountryCode: "CL",
-					Name:        "America/Punta_Arenas",
-				},
-				{
-					CountryCode: "CL",
-					Name:        "America/Santiago",
-				},
-				{
-					CountryCode: "CL",
-					Name:        "Pacific/Easter",
-				},
-			},
-		},
-		{
-			Code: "CN",
-			Name: "China",
-			Zones: []Zone{
-				{
-					CountryCode: "CN",
-					Name:        "Asia/Shanghai",
-				},
-				{
-					CountryCode: "CN",
-					Name:        "Asia/Urumqi",
-				},
-			},
-		},
-		{
-			Code: "CX",
-			Name: "Christmas Island",
-			Zones: []Zone{
-				{
-					CountryCode: "CX",
-					Name:        "Indian/Christmas",
-				},
-			},
-		},
-		{
-			Code: "CC",
-			Name: "Cocos (Keeling) Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "CC",
-					Name:        "Indian/Cocos",
-				},
-			},
-		},
-		{
-			Code: "CO",
-			Name: "Colombia",
-			Zones: []Zone{
-				{
-					CountryCode: "CO",
-					Name:        "America/Bogota",
-				},
-			},
-		},
-		{
-			Code: "KM",
-			Name: "Comoros",
-			Zones: []Zone{
-				{
-					CountryCode: "KM",
-					Name:        "Indian/Comoro",
-				},
-			},
-		},
-		{
-			Code: "CG",
-			Name: "Congo",
-			Zones: []Zone{
-				{
-					CountryCode: "CG",
-					Name:        "Africa/Brazzaville",
-				},
-			},
-		},
-		{
-			Code: "CD",
-			Name: "Congo, Democratic Republic of the",
-			Zones: []Zone{
-				{
-					CountryCode: "CD",
-					Name:        "Africa/Kinshasa",
-				},
-				{
-					CountryCode: "CD",
-					Name:        "Africa/Lubumbashi",
-				},
-			},
-		},
-		{
-			Code: "CK",
-			Name: "Cook Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "CK",
-					Name:        "Pacific/Rarotonga",
-				},
-			},
-		},
-		{
-			Code: "CR",
-			Name: "Costa Rica",
-			Zones: []Zone{
-				{
-					CountryCode: "CR",
-					Name:        "America/Costa_Rica",
-				},
-			},
-		},
-		{
-			Code: "HR",
-			Name: "Croatia",
-			Zones: []Zone{
-				{
-					CountryCode: "HR",
-					Name:        "Europe/Zagreb",
-				},
-			},
-		},
-		{
-			Code: "CU",
-			Name: "Cuba",
-			Zones: []Zone{
-				{
-					CountryCode: "CU",
-					Name:        "America/Havana",
-				},
-			},
-		},
-		{
-			Code: "CW",
-			Name: "Curaçao",
-			Zones: []Zone{
-				{
-					CountryCode: "CW",
-					Name:        "America/Curacao",
-				},
-			},
-		},
-		{
-			Code: "CY",
-			Name: "Cyprus",
-			Zones: []Zone{
-				{
-					CountryCode: "CY",
-					Name:        "Asia/Famagusta",
-				},
-				{
-					CountryCode: "CY",
-					Name:        "Asia/Nicosia",
-				},
-			},
-		},
-		{
-			Code: "CZ",
-			Name: "Czechia",
-			Zones: []Zone{
-				{
-					CountryCode: "CZ",
-					Name:        "Europe/Prague",
-				},
-			},
-		},
-		{
-			Code: "CI",
-			Name: "Côte d'Ivoire",
-			Zones: []Zone{
-				{
-					CountryCode: "CI",
-					Name:        "Africa/Abidjan",
-				},
-			},
-		},
-		{
-			Code: "DK",
-			Name: "Denmark",
-			Zones: []Zone{
-				{
-					CountryCode: "DK",
-					Name:        "Europe/Copenhagen",
-				},
-			},
-		},
-		{
-			Code: "DJ",
-			Name: "Djibouti",
-			Zones: []Zone{
-				{
-					CountryCode: "DJ",
-					Name:        "Africa/Djibouti",
-				},
-			},
-		},
-		{
-			Code: "DM",
-			Name: "Dominica",
-			Zones: []Zone{
-				{
-					CountryCode: "DM",
-					Name:        "America/Dominica",
-				},
-			},
-		},
-		{
-			Code: "DO",
-			Name: "Dominican Republic",
-			Zones: []Zone{
-				{
-					CountryCode: "DO",
-					Name:        "America/Santo_Domingo",
-				},
-			},
-		},
-		{
-			Code: "EC",
-			Name: "Ecuador",
-			Zones: []Zone{
-				{
-					CountryCode: "EC",
-					Name:        "America/Guayaquil",
-				},
-				{
-					CountryCode: "EC",
-					Name:        "Pacific/Galapagos",
-				},
-			},
-		},
-		{
-			Code: "EG",
-			Name: "Egypt",
-			Zones: []Zone{
-				{
-					CountryCode: "EG",
-					Name:        "Africa/Cairo",
-				},
-			},
-		},
-		{
-			Code: "SV",
-			Name: "El Salvador",
-			Zones: []Zone{
-				{
-					CountryCode: "SV",
-					Name:        "America/El_Salvador",
-				},
-			},
-		},
-		{
-			Code: "GQ",
-			Name: "Equatorial Guinea",
-			Zones: []Zone{
-				{
-					CountryCode: "GQ",
-					Name:        "Africa/Malabo",
-				},
-			},
-		},
-		{
-			Code: "ER",
-			Name: "Eritrea",
-			Zones: []Zone{
-				{
-					CountryCode: "ER",
-					Name:        "Africa/Asmara",
-				},
-			},
-		},
-		{
-			Code: "EE",
-			Name: "Estonia",
-			Zones: []Zone{
-				{
-					CountryCode: "EE",
-					Name:        "Europe/Tallinn",
-				},
-			},
-		},
-		{
-			Code: "SZ",
-			Name: "Eswatini",
-			Zones: []Zone{
-				{
-					CountryCode: "SZ",
-					Name:        "Africa/Mbabane",
-				},
-			},
-		},
-		{
-			Code: "ET",
-			Name: "Ethiopia",
-			Zones: []Zone{
-				{
-					CountryCode: "ET",
-					Name:        "Africa/Addis_Ababa",
-				},
-			},
-		},
-		{
-			Code: "FK",
-			Name: "Falkland Islands (Malvinas)",
-			Zones: []Zone{
-				{
-					CountryCode: "FK",
-					Name:        "Atlantic/Stanley",
-				},
-			},
-		},
-		{
-			Code: "FO",
-			Name: "Faroe Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "FO",
-					Name:        "Atlantic/Faroe",
-				},
-			},
-		},
-		{
-			Code: "FJ",
-			Name: "Fiji",
-			Zones: []Zone{
-				{
-					CountryCode: "FJ",
-					Name:        "Pacific/Fiji",
-				},
-			},
-		},
-		{
-			Code: "FI",
-			Name: "Finland",
-			Zones: []Zone{
-				{
-					CountryCode: "FI",
-					Name:        "Europe/Helsinki",
-				},
-			},
-		},
-		{
-			Code: "FR",
-			Name: "France",
-			Zones: []Zone{
-				{
-					CountryCode: "FR",
-					Name:        "Europe/Paris",
-				},
-			},
-		},
-		{
-			Code: "GF",
-			Name: "French Guiana",
-			Zones: []Zone{
-				{
-					CountryCode: "GF",
-					Name:        "America/Cayenne",
-				},
-			},
-		},
-		{
-			Code: "PF",
-			Name: "French Polynesia",
-			Zones: []Zone{
-				{
-					CountryCode: "PF",
-					Name:        "Pacific/Gambier",
-				},
-				{
-					CountryCode: "PF",
-					Name:        "Pacific/Marquesas",
-				},
-				{
-					CountryCode: "PF",
-					Name:        "Pacific/Tahiti",
-				},
-			},
-		},
-		{
-			Code: "TF",
-			Name: "French Southern Territories",
-			Zones: []Zone{
-				{
-					CountryCode: "TF",
-					Name:        "Indian/Kerguelen",
-				},
-			},
-		},
-		{
-			Code: "GA",
-			Name: "Gabon",
-			Zones: []Zone{
-				{
-					CountryCode: "GA",
-					Name:        "Africa/Libreville",
-				},
-			},
-		},
-		{
-			Code: "GM",
-			Name: "Gambia",
-			Zones: []Zone{
-				{
-					CountryCode: "GM",
-					Name:        "Africa/Banjul",
-				},
-			},
-		},
-		{
-			Code: "GE",
-			Name: "Georgia",
-			Zones: []Zone{
-				{
-					CountryCode: "GE",
-					Name:        "Asia/Tbilisi",
-				},
-			},
-		},
-		{
-			Code: "DE",
-			Name: "Germany",
-			Zones: []Zone{
-				{
-					CountryCode: "DE",
-					Name:        "Europe/Berlin",
-				},
-				{
-					CountryCode: "DE",
-					Name:        "Europe/Busingen",
-				},
-			},
-		},
-		{
-			Code: "GH",
-			Name: "Ghana",
-			Zones: []Zone{
-				{
-					CountryCode: "GH",
-					Name:        "Africa/Accra",
-				},
-			},
-		},
-		{
-			Code: "GI",
-			Name: "Gibraltar",
-			Zones: []Zone{
-				{
-					CountryCode: "GI",
-					Name:        "Europe/Gibraltar",
-				},
-			},
-		},
-		{
-			Code: "GR",
-			Name: "Greece",
-			Zones: []Zone{
-				{
-					CountryCode: "GR",
-					Name:        "Europe/Athens",
-				},
-			},
-		},
-		{
-			Code: "GL",
-			Name: "Greenland",
-			Zones: []Zone{
-				{
-					CountryCode: "GL",
-					Name:        "America/Danmarkshavn",
-				},
-				{
-					CountryCode: "GL",
-					Name:        "America/Nuuk",
-				},
-				{
-					CountryCode: "GL",
-					Name:        "America/Scoresbysund",
-				},
-				{
-					CountryCode: "GL",
-					Name:        "America/Thule",
-				},
-			},
-		},
-		{
-			Code: "GD",
-			Name: "Grenada",
-			Zones: []Zone{
-				{
-					CountryCode: "GD",
-					Name:        "America/Grenada",
-				},
-			},
-		},
-		{
-			Code: "GP",
-			Name: "Guadeloupe",
-			Zones: []Zone{
-				{
-					CountryCode: "GP",
-					Name:        "America/Guadeloupe",
-				},
-			},
-		},
-		{
-			Code: "GU",
-			Name: "Guam",
-			Zones: []Zone{
-				{
-					CountryCode: "GU",
-					Name:        "Pacific/Guam",
-				},
-			},
-		},
-		{
-			Code: "GT",
-			Name: "Guatemala",
-			Zones: []Zone{
-				{
-					CountryCode: "GT",
-					Name:        "America/Guatemala",
-				},
-			},
-		},
-		{
-			Code: "GG",
-			Name: "Guernsey",
-			Zones: []Zone{
-				{
-					CountryCode: "GG",
-					Name:        "Europe/Guernsey",
-				},
-			},
-		},
-		{
-			Code: "GN",
-			Name: "Guinea",
-			Zones: []Zone{
-				{
-					CountryCode: "GN",
-					Name:        "Africa/Conakry",
-				},
-			},
-		},
-		{
-			Code: "GW",
-			Name: "Guinea-Bissau",
-			Zones: []Zone{
-				{
-					CountryCode: "GW",
-					Name:        "Africa/Bissau",
-				},
-			},
-		},
-		{
-			Code: "GY",
-			Name: "Guyana",
-			Zones: []Zone{
-				{
-					CountryCode: "GY",
-					Name:        "America/Guyana",
-				},
-			},
-		},
-		{
-			Code: "HT",
-			Name: "Haiti",
-			Zones: []Zone{
-				{
-					CountryCode: "HT",
-					Name:        "America/Port-au-Prince",
-				},
-			},
-		},
-		{
-			Code:  "HM",
-			Name:  "Heard Island and McDonald Islands",
-			Zones: []Zone{},
-		},
-		{
-			Code: "VA",
-			Name: "Holy See",
-			Zones: []Zone{
-				{
-					CountryCode: "VA",
-					Name:        "Europe/Vatican",
-				},
-			},
-		},
-		{
-			Code: "HN",
-			Name: "Honduras",
-			Zones: []Zone{
-				{
-					CountryCode: "HN",
-					Name:        "America/Tegucigalpa",
-				},
-			},
-		},
-		{
-			Code: "HK",
-			Name: "Hong Kong",
-			Zones: []Zone{
-				{
-					CountryCode: "HK",
-					Name:        "Asia/Hong_Kong",
-				},
-			},
-		},
-		{
-			Code: "HU",
-			Name: "Hungary",
-			Zones: []Zone{
-				{
-					CountryCode: "HU",
-					Name:        "Europe/Budapest",
-				},
-			},
-		},
-		{
-			Code: "IS",
-			Name: "Iceland",
-			Zones: []Zone{
-				{
-					CountryCode: "IS",
-					Name:        "Atlantic/Reykjavik",
-				},
-			},
-		},
-		{
-			Code: "IN",
-			Name: "India",
-			Zones: []Zone{
-				{
-					CountryCode: "IN",
-					Name:        "Asia/Kolkata",
-				},
-			},
-		},
-		{
-			Code: "ID",
-			Name: "Indonesia",
-			Zones: []Zone{
-				{
-					CountryCode: "ID",
-					Name:        "Asia/Jakarta",
-				},
-				{
-					CountryCode: "ID",
-					Name:        "Asia/Jayapura",
-				},
-				{
-					CountryCode: "ID",
-					Name:        "Asia/Makassar",
-				},
-				{
-					CountryCode: "ID",
-					Name:        "Asia/Pontianak",
-				},
-			},
-		},
-		{
-			Code: "IR",
-			Name: "Iran (Islamic Republic of)",
-			Zones: []Zone{
-				{
-					CountryCode: "IR",
-					Name:        "Asia/Tehran",
-				},
-			},
-		},
-		{
-			Code: "IQ",
-			Name: "Iraq",
-			Zones: []Zone{
-				{
-					CountryCode: "IQ",
-					Name:        "Asia/Baghdad",
-				},
-			},
-		},
-		{
-			Code: "IE",
-			Name: "Ireland",
-			Zones: []Zone{
-				{
-					CountryCode: "IE",
-					Name:        "Europe/Dublin",
-				},
-			},
-		},
-		{
-			Code: "IM",
-			Name: "Isle of Man",
-			Zones: []Zone{
-				{
-					CountryCode: "IM",
-					Name:        "Europe/Isle_of_Man",
-				},
-			},
-		},
-		{
-			Code: "IL",
-			Name: "Israel",
-			Zones: []Zone{
-				{
-					CountryCode: "IL",
-					Name:        "Asia/Jerusalem",
-				},
-			},
-		},
-		{
-			Code: "IT",
-			Name: "Italy",
-			Zones: []Zone{
-				{
-					CountryCode: "IT",
-					Name:        "Europe/Rome",
-				},
-			},
-		},
-		{
-			Code: "JM",
-			Name: "Jamaica",
-			Zones: []Zone{
-				{
-					CountryCode: "JM",
-					Name:        "America/Jamaica",
-				},
-			},
-		},
-		{
-			Code: "JP",
-			Name: "Japan",
-			Zones: []Zone{
-				{
-					CountryCode: "JP",
-					Name:        "Asia/Tokyo",
-				},
-			},
-		},
-		{
-			Code: "JE",
-			Name: "Jersey",
-			Zones: []Zone{
-				{
-					CountryCode: "JE",
-					Name:        "Europe/Jersey",
-				},
-			},
-		},
-		{
-			Code: "JO",
-			Name: "Jordan",
-			Zones: []Zone{
-				{
-					CountryCode: "JO",
-					Name:        "Asia/Amman",
-				},
-			},
-		},
-		{
-			Code: "KZ",
-			Name: "Kazakhstan",
-			Zones: []Zone{
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Almaty",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Aqtau",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Aqtobe",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Atyrau",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Oral",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Qostanay",
-				},
-				{
-					CountryCode: "KZ",
-					Name:        "Asia/Qyzylorda",
-				},
-			},
-		},
-		{
-			Code: "KE",
-			Name: "Kenya",
-			Zones: []Zone{
-				{
-					CountryCode: "KE",
-					Name:        "Africa/Nairobi",
-				},
-			},
-		},
-		{
-			Code: "KI",
-			Name: "Kiribati",
-			Zones: []Zone{
-				{
-					CountryCode: "KI",
-					Name:        "Pacific/Kanton",
-				},
-				{
-					CountryCode: "KI",
-					Name:        "Pacific/Kiritimati",
-				},
-				{
-					CountryCode: "KI",
-					Name:        "Pacific/Tarawa",
-				},
-			},
-		},
-		{
-			Code: "KP",
-			Name: "Korea (Democratic People's Republic of)",
-			Zones: []Zone{
-				{
-					CountryCode: "KP",
-					Name:        "Asia/Pyongyang",
-				},
-			},
-		},
-		{
-			Code: "KR",
-			Name: "Korea, Republic of",
-			Zones: []Zone{
-				{
-					CountryCode: "KR",
-					Name:        "Asia/Seoul",
-				},
-			},
-		},
-		{
-			Code: "KW",
-			Name: "Kuwait",
-			Zones: []Zone{
-				{
-					CountryCode: "KW",
-					Name:        "Asia/Kuwait",
-				},
-			},
-		},
-		{
-			Code: "KG",
-			Name: "Kyrgyzstan",
-			Zones: []Zone{
-				{
-					CountryCode: "KG",
-					Name:        "Asia/Bishkek",
-				},
-			},
-		},
-		{
-			Code: "LA",
-			Name: "Lao People's Democratic Republic",
-			Zones: []Zone{
-				{
-					CountryCode: "LA",
-					Name:        "Asia/Vientiane",
-				},
-			},
-		},
-		{
-			Code: "LV",
-			Name: "Latvia",
-			Zones: []Zone{
-				{
-					CountryCode: "LV",
-					Name:        "Europe/Riga",
-				},
-			},
-		},
-		{
-			Code: "LB",
-			Name: "Lebanon",
-			Zones: []Zone{
-				{
-					CountryCode: "LB",
-					Name:        "Asia/Beirut",
-				},
-			},
-		},
-		{
-			Code: "LS",
-			Name: "Lesotho",
-			Zones: []Zone{
-				{
-					CountryCode: "LS",
-					Name:        "Africa/Maseru",
-				},
-			},
-		},
-		{
-			Code: "LR",
-			Name: "Liberia",
-			Zones: []Zone{
-				{
-					CountryCode: "LR",
-					Name:        "Africa/Monrovia",
-				},
-			},
-		},
-		{
-			Code: "LY",
-			Name: "Libya",
-			Zones: []Zone{
-				{
-					CountryCode: "LY",
-					Name:        "Africa/Tripoli",
-				},
-			},
-		},
-		{
-			Code: "LI",
-			Name: "Liechtenstein",
-			Zones: []Zone{
-				{
-					CountryCode: "LI",
-					Name:        "Europe/Vaduz",
-				},
-			},
-		},
-		{
-			Code: "LT",
-			Name: "Lithuania",
-			Zones: []Zone{
-				{
-					CountryCode: "LT",
-					Name:        "Europe/Vilnius",
-				},
-			},
-		},
-		{
-			Code: "LU",
-			Name: "Luxembourg",
-			Zones: []Zone{
-				{
-					CountryCode: "LU",
-					Name:        "Europe/Luxembourg",
-				},
-			},
-		},
-		{
-			Code: "MO",
-			Name: "Macao",
-			Zones: []Zone{
-				{
-					CountryCode: "MO",
-					Name:        "Asia/Macau",
-				},
-			},
-		},
-		{
-			Code: "MG",
-			Name: "Madagascar",
-			Zones: []Zone{
-				{
-					CountryCode: "MG",
-					Name:        "Indian/Antananarivo",
-				},
-			},
-		},
-		{
-			Code: "MW",
-			Name: "Malawi",
-			Zones: []Zone{
-				{
-					CountryCode: "MW",
-					Name:        "Africa/Blantyre",
-				},
-			},
-		},
-		{
-			Code: "MY",
-			Name: "Malaysia",
-			Zones: []Zone{
-				{
-					CountryCode: "MY",
-					Name:        "Asia/Kuala_Lumpur",
-				},
-				{
-					CountryCode: "MY",
-					Name:        "Asia/Kuching",
-				},
-			},
-		},
-		{
-			Code: "MV",
-			Name: "Maldives",
-			Zones: []Zone{
-				{
-					CountryCode: "MV",
-					Name:        "Indian/Maldives",
-				},
-			},
-		},
-		{
-			Code: "ML",
-			Name: "Mali",
-			Zones: []Zone{
-				{
-					CountryCode: "ML",
-					Name:        "Africa/Bamako",
-				},
-			},
-		},
-		{
-			Code: "MT",
-			Name: "Malta",
-			Zones: []Zone{
-				{
-					CountryCode: "MT",
-					Name:        "Europe/Malta",
-				},
-			},
-		},
-		{
-			Code: "MH",
-			Name: "Marshall Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "MH",
-					Name:        "Pacific/Kwajalein",
-				},
-				{
-					CountryCode: "MH",
-					Name:        "Pacific/Majuro",
-				},
-			},
-		},
-		{
-			Code: "MQ",
-			Name: "Martinique",
-			Zones: []Zone{
-				{
-					CountryCode: "MQ",
-					Name:        "America/Martinique",
-				},
-			},
-		},
-		{
-			Code: "MR",
-			Name: "Mauritania",
-			Zones: []Zone{
-				{
-					CountryCode: "MR",
-					Name:        "Africa/Nouakchott",
-				},
-			},
-		},
-		{
-			Code: "MU",
-			Name: "Mauritius",
-			Zones: []Zone{
-				{
-					CountryCode: "MU",
-					Name:        "Indian/Mauritius",
-				},
-			},
-		},
-		{
-			Code: "YT",
-			Name: "Mayotte",
-			Zones: []Zone{
-				{
-					CountryCode: "YT",
-					Name:        "Indian/Mayotte",
-				},
-			},
-		},
-		{
-			Code: "MX",
-			Name: "Mexico",
-			Zones: []Zone{
-				{
-					CountryCode: "MX",
-					Name:        "America/Bahia_Banderas",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Cancun",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Chihuahua",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Hermosillo",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Matamoros",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Mazatlan",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Merida",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Mexico_City",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Monterrey",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Ojinaga",
-				},
-				{
-					CountryCode: "MX",
-					Name:        "America/Tijuana",
-				},
-			},
-		},
-		{
-			Code: "FM",
-			Name: "Micronesia (Federated States of)",
-			Zones: []Zone{
-				{
-					CountryCode: "FM",
-					Name:        "Pacific/Chuuk",
-				},
-				{
-					CountryCode: "FM",
-					Name:        "Pacific/Kosrae",
-				},
-				{
-					CountryCode: "FM",
-					Name:        "Pacific/Pohnpei",
-				},
-			},
-		},
-		{
-			Code: "MD",
-			Name: "Moldova, Republic of",
-			Zones: []Zone{
-				{
-					CountryCode: "MD",
-					Name:        "Europe/Chisinau",
-				},
-			},
-		},
-		{
-			Code: "MC",
-			Name: "Monaco",
-			Zones: []Zone{
-				{
-					CountryCode: "MC",
-					Name:        "Europe/Monaco",
-				},
-			},
-		},
-		{
-			Code: "MN",
-			Name: "Mongolia",
-			Zones: []Zone{
-				{
-					CountryCode: "MN",
-					Name:        "Asia/Choibalsan",
-				},
-				{
-					CountryCode: "MN",
-					Name:        "Asia/Hovd",
-				},
-				{
-					CountryCode: "MN",
-					Name:        "Asia/Ulaanbaatar",
-				},
-			},
-		},
-		{
-			Code: "ME",
-			Name: "Montenegro",
-			Zones: []Zone{
-				{
-					CountryCode: "ME",
-					Name:        "Europe/Podgorica",
-				},
-			},
-		},
-		{
-			Code: "MS",
-			Name: "Montserrat",
-			Zones: []Zone{
-				{
-					CountryCode: "MS",
-					Name:        "America/Montserrat",
-				},
-			},
-		},
-		{
-			Code: "MA",
-			Name: "Morocco",
-			Zones: []Zone{
-				{
-					CountryCode: "MA",
-					Name:        "Africa/Casablanca",
-				},
-			},
-		},
-		{
-			Code: "MZ",
-			Name: "Mozambique",
-			Zones: []Zone{
-				{
-					CountryCode: "MZ",
-					Name:        "Africa/Maputo",
-				},
-			},
-		},
-		{
-			Code: "MM",
-			Name: "Myanmar",
-			Zones: []Zone{
-				{
-					CountryCode: "MM",
-					Name:        "Asia/Yangon",
-				},
-			},
-		},
-		{
-			Code: "NA",
-			Name: "Namibia",
-			Zones: []Zone{
-				{
-					CountryCode: "NA",
-					Name:        "Africa/Windhoek",
-				},
-			},
-		},
-		{
-			Code: "NR",
-			Name: "Nauru",
-			Zones: []Zone{
-				{
-					CountryCode: "NR",
-					Name:        "Pacific/Nauru",
-				},
-			},
-		},
-		{
-			Code: "NP",
-			Name: "Nepal",
-			Zones: []Zone{
-				{
-					CountryCode: "NP",
-					Name:        "Asia/Kathmandu",
-				},
-			},
-		},
-		{
-			Code: "NL",
-			Name: "Netherlands",
-			Zones: []Zone{
-				{
-					CountryCode: "NL",
-					Name:        "Europe/Amsterdam",
-				},
-			},
-		},
-		{
-			Code: "NC",
-			Name: "New Caledonia",
-			Zones: []Zone{
-				{
-					CountryCode: "NC",
-					Name:        "Pacific/Noumea",
-				},
-			},
-		},
-		{
-			Code: "NZ",
-			Name: "New Zealand",
-			Zones: []Zone{
-				{
-					CountryCode: "NZ",
-					Name:        "Pacific/Auckland",
-				},
-				{
-					CountryCode: "NZ",
-					Name:        "Pacific/Chatham",
-				},
-			},
-		},
-		{
-			Code: "NI",
-			Name: "Nicaragua",
-			Zones: []Zone{
-				{
-					CountryCode: "NI",
-					Name:        "America/Managua",
-				},
-			},
-		},
-		{
-			Code: "NE",
-			Name: "Niger",
-			Zones: []Zone{
-				{
-					CountryCode: "NE",
-					Name:        "Africa/Niamey",
-				},
-			},
-		},
-		{
-			Code: "NG",
-			Name: "Nigeria",
-			Zones: []Zone{
-				{
-					CountryCode: "NG",
-					Name:        "Africa/Lagos",
-				},
-			},
-		},
-		{
-			Code: "NU",
-			Name: "Niue",
-			Zones: []Zone{
-				{
-					CountryCode: "NU",
-					Name:        "Pacific/Niue",
-				},
-			},
-		},
-		{
-			Code: "NF",
-			Name: "Norfolk Island",
-			Zones: []Zone{
-				{
-					CountryCode: "NF",
-					Name:        "Pacific/Norfolk",
-				},
-			},
-		},
-		{
-			Code: "MK",
-			Name: "North Macedonia",
-			Zones: []Zone{
-				{
-					CountryCode: "MK",
-					Name:        "Europe/Skopje",
-				},
-			},
-		},
-		{
-			Code: "MP",
-			Name: "Northern Mariana Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "MP",
-					Name:        "Pacific/Saipan",
-				},
-			},
-		},
-		{
-			Code: "NO",
-			Name: "Norway",
-			Zones: []Zone{
-				{
-					CountryCode: "NO",
-					Name:        "Europe/Oslo",
-				},
-			},
-		},
-		{
-			Code: "OM",
-			Name: "Oman",
-			Zones: []Zone{
-				{
-					CountryCode: "OM",
-					Name:        "Asia/Muscat",
-				},
-			},
-		},
-		{
-			Code: "PK",
-			Name: "Pakistan",
-			Zones: []Zone{
-				{
-					CountryCode: "PK",
-					Name:        "Asia/Karachi",
-				},
-			},
-		},
-		{
-			Code: "PW",
-			Name: "Palau",
-			Zones: []Zone{
-				{
-					CountryCode: "PW",
-					Name:        "Pacific/Palau",
-				},
-			},
-		},
-		{
-			Code: "PS",
-			Name: "Palestine, State of",
-			Zones: []Zone{
-				{
-					CountryCode: "PS",
-					Name:        "Asia/Gaza",
-				},
-				{
-					CountryCode: "PS",
-					Name:        "Asia/Hebron",
-				},
-			},
-		},
-		{
-			Code: "PA",
-			Name: "Panama",
-			Zones: []Zone{
-				{
-					CountryCode: "PA",
-					Name:        "America/Panama",
-				},
-			},
-		},
-		{
-			Code: "PG",
-			Name: "Papua New Guinea",
-			Zones: []Zone{
-				{
-					CountryCode: "PG",
-					Name:        "Pacific/Bougainville",
-				},
-				{
-					CountryCode: "PG",
-					Name:        "Pacific/Port_Moresby",
-				},
-			},
-		},
-		{
-			Code: "PY",
-			Name: "Paraguay",
-			Zones: []Zone{
-				{
-					CountryCode: "PY",
-					Name:        "America/Asuncion",
-				},
-			},
-		},
-		{
-			Code: "PE",
-			Name: "Peru",
-			Zones: []Zone{
-				{
-					CountryCode: "PE",
-					Name:        "America/Lima",
-				},
-			},
-		},
-		{
-			Code: "PH",
-			Name: "Philippines",
-			Zones: []Zone{
-				{
-					CountryCode: "PH",
-					Name:        "Asia/Manila",
-				},
-			},
-		},
-		{
-			Code: "PN",
-			Name: "Pitcairn",
-			Zones: []Zone{
-				{
-					CountryCode: "PN",
-					Name:        "Pacific/Pitcairn",
-				},
-			},
-		},
-		{
-			Code: "PL",
-			Name: "Poland",
-			Zones: []Zone{
-				{
-					CountryCode: "PL",
-					Name:        "Europe/Warsaw",
-				},
-			},
-		},
-		{
-			Code: "PT",
-			Name: "Portugal",
-			Zones: []Zone{
-				{
-					CountryCode: "PT",
-					Name:        "Atlantic/Azores",
-				},
-				{
-					CountryCode: "PT",
-					Name:        "Atlantic/Madeira",
-				},
-				{
-					CountryCode: "PT",
-					Name:        "Europe/Lisbon",
-				},
-			},
-		},
-		{
-			Code: "PR",
-			Name: "Puerto Rico",
-			Zones: []Zone{
-				{
-					CountryCode: "PR",
-					Name:        "America/Puerto_Rico",
-				},
-			},
-		},
-		{
-			Code: "QA",
-			Name: "Qatar",
-			Zones: []Zone{
-				{
-					CountryCode: "QA",
-					Name:        "Asia/Qatar",
-				},
-			},
-		},
-		{
-			Code: "RO",
-			Name: "Romania",
-			Zones: []Zone{
-				{
-					CountryCode: "RO",
-					Name:        "Europe/Bucharest",
-				},
-			},
-		},
-		{
-			Code: "RU",
-			Name: "Russian Federation",
-			Zones: []Zone{
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Anadyr",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Barnaul",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Chita",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Irkutsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Kamchatka",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Khandyga",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Krasnoyarsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Magadan",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Novokuznetsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Novosibirsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Omsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Sakhalin",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Srednekolymsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Tomsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Ust-Nera",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Vladivostok",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Yakutsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Asia/Yekaterinburg",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Astrakhan",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Kaliningrad",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Kirov",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Moscow",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Samara",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Saratov",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Ulyanovsk",
-				},
-				{
-					CountryCode: "RU",
-					Name:        "Europe/Volgograd",
-				},
-			},
-		},
-		{
-			Code: "RW",
-			Name: "Rwanda",
-			Zones: []Zone{
-				{
-					CountryCode: "RW",
-					Name:        "Africa/Kigali",
-				},
-			},
-		},
-		{
-			Code: "RE",
-			Name: "Réunion",
-			Zones: []Zone{
-				{
-					CountryCode: "RE",
-					Name:        "Indian/Reunion",
-				},
-			},
-		},
-		{
-			Code: "BL",
-			Name: "Saint Barthélemy",
-			Zones: []Zone{
-				{
-					CountryCode: "BL",
-					Name:        "America/St_Barthelemy",
-				},
-			},
-		},
-		{
-			Code: "SH",
-			Name: "Saint Helena, Ascension and Tristan da Cunha",
-			Zones: []Zone{
-				{
-					CountryCode: "SH",
-					Name:        "Atlantic/St_Helena",
-				},
-			},
-		},
-		{
-			Code: "KN",
-			Name: "Saint Kitts and Nevis",
-			Zones: []Zone{
-				{
-					CountryCode: "KN",
-					Name:        "America/St_Kitts",
-				},
-			},
-		},
-		{
-			Code: "LC",
-			Name: "Saint Lucia",
-			Zones: []Zone{
-				{
-					CountryCode: "LC",
-					Name:        "America/St_Lucia",
-				},
-			},
-		},
-		{
-			Code: "MF",
-			Name: "Saint Martin (French part)",
-			Zones: []Zone{
-				{
-					CountryCode: "MF",
-					Name:        "America/Marigot",
-				},
-			},
-		},
-		{
-			Code: "PM",
-			Name: "Saint Pierre and Miquelon",
-			Zones: []Zone{
-				{
-					CountryCode: "PM",
-					Name:        "America/Miquelon",
-				},
-			},
-		},
-		{
-			Code: "VC",
-			Name: "Saint Vincent and the Grenadines",
-			Zones: []Zone{
-				{
-					CountryCode: "VC",
-					Name:        "America/St_Vincent",
-				},
-			},
-		},
-		{
-			Code: "WS",
-			Name: "Samoa",
-			Zones: []Zone{
-				{
-					CountryCode: "WS",
-					Name:        "Pacific/Apia",
-				},
-			},
-		},
-		{
-			Code: "SM",
-			Name: "San Marino",
-			Zones: []Zone{
-				{
-					CountryCode: "SM",
-					Name:        "Europe/San_Marino",
-				},
-			},
-		},
-		{
-			Code: "ST",
-			Name: "Sao Tome and Principe",
-			Zones: []Zone{
-				{
-					CountryCode: "ST",
-					Name:        "Africa/Sao_Tome",
-				},
-			},
-		},
-		{
-			Code: "SA",
-			Name: "Saudi Arabia",
-			Zones: []Zone{
-				{
-					CountryCode: "SA",
-					Name:        "Asia/Riyadh",
-				},
-			},
-		},
-		{
-			Code: "SN",
-			Name: "Senegal",
-			Zones: []Zone{
-				{
-					CountryCode: "SN",
-					Name:        "Africa/Dakar",
-				},
-			},
-		},
-		{
-			Code: "RS",
-			Name: "Serbia",
-			Zones: []Zone{
-				{
-					CountryCode: "RS",
-					Name:        "Europe/Belgrade",
-				},
-			},
-		},
-		{
-			Code: "SC",
-			Name: "Seychelles",
-			Zones: []Zone{
-				{
-					CountryCode: "SC",
-					Name:        "Indian/Mahe",
-				},
-			},
-		},
-		{
-			Code: "SL",
-			Name: "Sierra Leone",
-			Zones: []Zone{
-				{
-					CountryCode: "SL",
-					Name:        "Africa/Freetown",
-				},
-			},
-		},
-		{
-			Code: "SG",
-			Name: "Singapore",
-			Zones: []Zone{
-				{
-					CountryCode: "SG",
-					Name:        "Asia/Singapore",
-				},
-			},
-		},
-		{
-			Code: "SX",
-			Name: "Sint Maarten (Dutch part)",
-			Zones: []Zone{
-				{
-					CountryCode: "SX",
-					Name:        "America/Lower_Princes",
-				},
-			},
-		},
-		{
-			Code: "SK",
-			Name: "Slovakia",
-			Zones: []Zone{
-				{
-					CountryCode: "SK",
-					Name:        "Europe/Bratislava",
-				},
-			},
-		},
-		{
-			Code: "SI",
-			Name: "Slovenia",
-			Zones: []Zone{
-				{
-					CountryCode: "SI",
-					Name:        "Europe/Ljubljana",
-				},
-			},
-		},
-		{
-			Code: "SB",
-			Name: "Solomon Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "SB",
-					Name:        "Pacific/Guadalcanal",
-				},
-			},
-		},
-		{
-			Code: "SO",
-			Name: "Somalia",
-			Zones: []Zone{
-				{
-					CountryCode: "SO",
-					Name:        "Africa/Mogadishu",
-				},
-			},
-		},
-		{
-			Code: "ZA",
-			Name: "South Africa",
-			Zones: []Zone{
-				{
-					CountryCode: "ZA",
-					Name:        "Africa/Johannesburg",
-				},
-			},
-		},
-		{
-			Code: "GS",
-			Name: "South Georgia and the South Sandwich Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "GS",
-					Name:        "Atlantic/South_Georgia",
-				},
-			},
-		},
-		{
-			Code: "SS",
-			Name: "South Sudan",
-			Zones: []Zone{
-				{
-					CountryCode: "SS",
-					Name:        "Africa/Juba",
-				},
-			},
-		},
-		{
-			Code: "ES",
-			Name: "Spain",
-			Zones: []Zone{
-				{
-					CountryCode: "ES",
-					Name:        "Africa/Ceuta",
-				},
-				{
-					CountryCode: "ES",
-					Name:        "Atlantic/Canary",
-				},
-				{
-					CountryCode: "ES",
-					Name:        "Europe/Madrid",
-				},
-			},
-		},
-		{
-			Code: "LK",
-			Name: "Sri Lanka",
-			Zones: []Zone{
-				{
-					CountryCode: "LK",
-					Name:        "Asia/Colombo",
-				},
-			},
-		},
-		{
-			Code: "SD",
-			Name: "Sudan",
-			Zones: []Zone{
-				{
-					CountryCode: "SD",
-					Name:        "Africa/Khartoum",
-				},
-			},
-		},
-		{
-			Code: "SR",
-			Name: "Suriname",
-			Zones: []Zone{
-				{
-					CountryCode: "SR",
-					Name:        "America/Paramaribo",
-				},
-			},
-		},
-		{
-			Code: "SJ",
-			Name: "Svalbard and Jan Mayen",
-			Zones: []Zone{
-				{
-					CountryCode: "SJ",
-					Name:        "Arctic/Longyearbyen",
-				},
-			},
-		},
-		{
-			Code: "SE",
-			Name: "Sweden",
-			Zones: []Zone{
-				{
-					CountryCode: "SE",
-					Name:        "Europe/Stockholm",
-				},
-			},
-		},
-		{
-			Code: "CH",
-			Name: "Switzerland",
-			Zones: []Zone{
-				{
-					CountryCode: "CH",
-					Name:        "Europe/Zurich",
-				},
-			},
-		},
-		{
-			Code: "SY",
-			Name: "Syrian Arab Republic",
-			Zones: []Zone{
-				{
-					CountryCode: "SY",
-					Name:        "Asia/Damascus",
-				},
-			},
-		},
-		{
-			Code: "TW",
-			Name: "Taiwan, Province of China",
-			Zones: []Zone{
-				{
-					CountryCode: "TW",
-					Name:        "Asia/Taipei",
-				},
-			},
-		},
-		{
-			Code: "TJ",
-			Name: "Tajikistan",
-			Zones: []Zone{
-				{
-					CountryCode: "TJ",
-					Name:        "Asia/Dushanbe",
-				},
-			},
-		},
-		{
-			Code: "TZ",
-			Name: "Tanzania, United Republic of",
-			Zones: []Zone{
-				{
-					CountryCode: "TZ",
-					Name:        "Africa/Dar_es_Salaam",
-				},
-			},
-		},
-		{
-			Code: "TH",
-			Name: "Thailand",
-			Zones: []Zone{
-				{
-					CountryCode: "TH",
-					Name:        "Asia/Bangkok",
-				},
-			},
-		},
-		{
-			Code: "TL",
-			Name: "Timor-Leste",
-			Zones: []Zone{
-				{
-					CountryCode: "TL",
-					Name:        "Asia/Dili",
-				},
-			},
-		},
-		{
-			Code: "TG",
-			Name: "Togo",
-			Zones: []Zone{
-				{
-					CountryCode: "TG",
-					Name:        "Africa/Lome",
-				},
-			},
-		},
-		{
-			Code: "TK",
-			Name: "Tokelau",
-			Zones: []Zone{
-				{
-					CountryCode: "TK",
-					Name:        "Pacific/Fakaofo",
-				},
-			},
-		},
-		{
-			Code: "TO",
-			Name: "Tonga",
-			Zones: []Zone{
-				{
-					CountryCode: "TO",
-					Name:        "Pacific/Tongatapu",
-				},
-			},
-		},
-		{
-			Code: "TT",
-			Name: "Trinidad and Tobago",
-			Zones: []Zone{
-				{
-					CountryCode: "TT",
-					Name:        "America/Port_of_Spain",
-				},
-			},
-		},
-		{
-			Code: "TN",
-			Name: "Tunisia",
-			Zones: []Zone{
-				{
-					CountryCode: "TN",
-					Name:        "Africa/Tunis",
-				},
-			},
-		},
-		{
-			Code: "TR",
-			Name: "Turkey",
-			Zones: []Zone{
-				{
-					CountryCode: "TR",
-					Name:        "Europe/Istanbul",
-				},
-			},
-		},
-		{
-			Code: "TM",
-			Name: "Turkmenistan",
-			Zones: []Zone{
-				{
-					CountryCode: "TM",
-					Name:        "Asia/Ashgabat",
-				},
-			},
-		},
-		{
-			Code: "TC",
-			Name: "Turks and Caicos Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "TC",
-					Name:        "America/Grand_Turk",
-				},
-			},
-		},
-		{
-			Code: "TV",
-			Name: "Tuvalu",
-			Zones: []Zone{
-				{
-					CountryCode: "TV",
-					Name:        "Pacific/Funafuti",
-				},
-			},
-		},
-		{
-			Code: "UG",
-			Name: "Uganda",
-			Zones: []Zone{
-				{
-					CountryCode: "UG",
-					Name:        "Africa/Kampala",
-				},
-			},
-		},
-		{
-			Code: "UA",
-			Name: "Ukraine",
-			Zones: []Zone{
-				{
-					CountryCode: "UA",
-					Name:        "Europe/Kiev",
-				},
-				{
-					CountryCode: "UA",
-					Name:        "Europe/Simferopol",
-				},
-				{
-					CountryCode: "UA",
-					Name:        "Europe/Uzhgorod",
-				},
-				{
-					CountryCode: "UA",
-					Name:        "Europe/Zaporozhye",
-				},
-			},
-		},
-		{
-			Code: "AE",
-			Name: "United Arab Emirates",
-			Zones: []Zone{
-				{
-					CountryCode: "AE",
-					Name:        "Asia/Dubai",
-				},
-			},
-		},
-		{
-			Code: "GB",
-			Name: "United Kingdom of Great Britain and Northern Ireland",
-			Zones: []Zone{
-				{
-					CountryCode: "GB",
-					Name:        "Europe/London",
-				},
-			},
-		},
-		{
-			Code: "UM",
-			Name: "United States Minor Outlying Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "UM",
-					Name:        "Pacific/Midway",
-				},
-				{
-					CountryCode: "UM",
-					Name:        "Pacific/Wake",
-				},
-			},
-		},
-		{
-			Code: "US",
-			Name: "United States of America",
-			Zones: []Zone{
-				{
-					CountryCode: "US",
-					Name:        "America/Adak",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Anchorage",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Boise",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Chicago",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Denver",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Detroit",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Indianapolis",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Knox",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Marengo",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Petersburg",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Tell_City",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Vevay",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Vincennes",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Indiana/Winamac",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Juneau",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Kentucky/Louisville",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Kentucky/Monticello",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Los_Angeles",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Menominee",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Metlakatla",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/New_York",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Nome",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/North_Dakota/Beulah",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/North_Dakota/Center",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/North_Dakota/New_Salem",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Phoenix",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Sitka",
-				},
-				{
-					CountryCode: "US",
-					Name:        "America/Yakutat",
-				},
-				{
-					CountryCode: "US",
-					Name:        "Pacific/Honolulu",
-				},
-			},
-		},
-		{
-			Code: "UY",
-			Name: "Uruguay",
-			Zones: []Zone{
-				{
-					CountryCode: "UY",
-					Name:        "America/Montevideo",
-				},
-			},
-		},
-		{
-			Code: "UZ",
-			Name: "Uzbekistan",
-			Zones: []Zone{
-				{
-					CountryCode: "UZ",
-					Name:        "Asia/Samarkand",
-				},
-				{
-					CountryCode: "UZ",
-					Name:        "Asia/Tashkent",
-				},
-			},
-		},
-		{
-			Code: "VU",
-			Name: "Vanuatu",
-			Zones: []Zone{
-				{
-					CountryCode: "VU",
-					Name:        "Pacific/Efate",
-				},
-			},
-		},
-		{
-			Code: "VE",
-			Name: "Venezuela (Bolivarian Republic of)",
-			Zones: []Zone{
-				{
-					CountryCode: "VE",
-					Name:        "America/Caracas",
-				},
-			},
-		},
-		{
-			Code: "VN",
-			Name: "Viet Nam",
-			Zones: []Zone{
-				{
-					CountryCode: "VN",
-					Name:        "Asia/Ho_Chi_Minh",
-				},
-			},
-		},
-		{
-			Code: "VG",
-			Name: "Virgin Islands (British)",
-			Zones: []Zone{
-				{
-					CountryCode: "VG",
-					Name:        "America/Tortola",
-				},
-			},
-		},
-		{
-			Code: "VI",
-			Name: "Virgin Islands (U.S.)",
-			Zones: []Zone{
-				{
-					CountryCode: "VI",
-					Name:        "America/St_Thomas",
-				},
-			},
-		},
-		{
-			Code: "WF",
-			Name: "Wallis and Futuna",
-			Zones: []Zone{
-				{
-					CountryCode: "WF",
-					Name:        "Pacific/Wallis",
-				},
-			},
-		},
-		{
-			Code: "EH",
-			Name: "Western Sahara",
-			Zones: []Zone{
-				{
-					CountryCode: "EH",
-					Name:        "Africa/El_Aaiun",
-				},
-			},
-		},
-		{
-			Code: "YE",
-			Name: "Yemen",
-			Zones: []Zone{
-				{
-					CountryCode: "YE",
-					Name:        "Asia/Aden",
-				},
-			},
-		},
-		{
-			Code: "ZM",
-			Name: "Zambia",
-			Zones: []Zone{
-				{
-					CountryCode: "ZM",
-					Name:        "Africa/Lusaka",
-				},
-			},
-		},
-		{
-			Code: "ZW",
-			Name: "Zimbabwe",
-			Zones: []Zone{
-				{
-					CountryCode: "ZW",
-					Name:        "Africa/Harare",
-				},
-			},
-		},
-		{
-			Code: "AX",
-			Name: "Åland Islands",
-			Zones: []Zone{
-				{
-					CountryCode: "AX",
-					Name:        "Europe/Mariehamn",
-				},
-			},
-		},
-	}
-)
-
-func init() {
-	// load + index countries into map
-	// for below functions.
+const (
+	dataSource      = "https://timezonedb.com"
+	dataLicense     = "CC BY 3.0 https://creativecommons.org/licenses/by/3.0/"
+	dataAttribution = "Timezone data provided by TimeZoneDB (https://timezonedb.com), licensed under CC BY 3.0."
 
-	once.Do(func() {
-		mapped = make(map[string]Country)
-
-		for i := 0; i < len(countries); i++ {
-			mapped[countries[i].Code] = countries[i]
-		}
-	})
-}
+	provenanceSourceURL  = "https://timezonedb.com/files/timezonedb.csv.zip"
+	provenanceRelease    = ""
+	provenanceDownloaded = ""
+	provenanceChecksum   = ""
+	provenanceGenerator  = "f8e228b6c9a52cd6205afec9dd41ea4cb77baf2f"
+)
 
-// GetCountries returns an array of all countries.
-// Most common use: for loading into a country dropdown
-// in HTML.
-func GetCountries() []Country {
-	return countries
+var literalCountries = []Country{
+	{
+		Code:     "AF",
+		Name:     "Afghanistan",
+		Ordinal:  3,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AF",
+				Name:        "Asia/Kabul",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AL",
+		Name:     "Albania",
+		Ordinal:  6,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AL",
+				Name:        "Europe/Tirane",
+			},
+		},
+	},
+	{
+		Code:     "DZ",
+		Name:     "Algeria",
+		Ordinal:  62,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DZ",
+				Name:        "Africa/Algiers",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AS",
+		Name:     "American Samoa",
+		Ordinal:  11,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AS",
+				Name:        "Pacific/Pago_Pago",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AD",
+		Name:     "Andorra",
+		Ordinal:  1,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AD",
+				Name:        "Europe/Andorra",
+			},
+		},
+	},
+	{
+		Code:     "AO",
+		Name:     "Angola",
+		Ordinal:  8,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AO",
+				Name:        "Africa/Luanda",
+			},
+		},
+	},
+	{
+		Code:     "AI",
+		Name:     "Anguilla",
+		Ordinal:  5,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AI",
+				Name:        "America/Anguilla",
+			},
+		},
+	},
+	{
+		Code:     "AQ",
+		Name:     "Antarctica",
+		Ordinal:  9,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Casey",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Davis",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/DumontDUrville",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Mawson",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/McMurdo",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Palmer",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Rothera",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Syowa",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Troll",
+			},
+			{
+				CountryCode: "AQ",
+				Name:        "Antarctica/Vostok",
+			},
+		},
+	},
+	{
+		Code:     "AG",
+		Name:     "Antigua and Barbuda",
+		Ordinal:  4,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AG",
+				Name:        "America/Antigua",
+			},
+		},
+	},
+	{
+		Code:     "AR",
+		Name:     "Argentina",
+		Ordinal:  10,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Buenos_Aires",
+				Common:      true,
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Catamarca",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Cordoba",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Jujuy",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/La_Rioja",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Mendoza",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Rio_Gallegos",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Salta",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/San_Juan",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/San_Luis",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Tucuman",
+			},
+			{
+				CountryCode: "AR",
+				Name:        "America/Argentina/Ushuaia",
+			},
+		},
+	},
+	{
+		Code:     "AM",
+		Name:     "Armenia",
+		Ordinal:  7,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AM",
+				Name:        "Asia/Yerevan",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AW",
+		Name:     "Aruba",
+		Ordinal:  14,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AW",
+				Name:        "America/Aruba",
+			},
+		},
+	},
+	{
+		Code:     "AU",
+		Name:     "Australia",
+		Ordinal:  13,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AU",
+				Name:        "Antarctica/Macquarie",
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Adelaide",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Brisbane",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Broken_Hill",
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Darwin",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Eucla",
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Hobart",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Lindeman",
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Lord_Howe",
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Melbourne",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Perth",
+				Common:      true,
+			},
+			{
+				CountryCode: "AU",
+				Name:        "Australia/Sydney",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AT",
+		Name:     "Austria",
+		Ordinal:  12,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AT",
+				Name:        "Europe/Vienna",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AZ",
+		Name:     "Azerbaijan",
+		Ordinal:  16,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AZ",
+				Name:        "Asia/Baku",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BS",
+		Name:     "Bahamas",
+		Ordinal:  32,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BS",
+				Name:        "America/Nassau",
+			},
+		},
+	},
+	{
+		Code:     "BH",
+		Name:     "Bahrain",
+		Ordinal:  23,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BH",
+				Name:        "Asia/Bahrain",
+			},
+		},
+	},
+	{
+		Code:     "BD",
+		Name:     "Bangladesh",
+		Ordinal:  19,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BD",
+				Name:        "Asia/Dhaka",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BB",
+		Name:     "Barbados",
+		Ordinal:  18,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BB",
+				Name:        "America/Barbados",
+			},
+		},
+	},
+	{
+		Code:     "BY",
+		Name:     "Belarus",
+		Ordinal:  36,
+		Synonyms: []string{"Byelorussia", "Belorussia"},
+		Zones: []Zone{
+			{
+				CountryCode: "BY",
+				Name:        "Europe/Minsk",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BE",
+		Name:     "Belgium",
+		Ordinal:  20,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BE",
+				Name:        "Europe/Brussels",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BZ",
+		Name:     "Belize",
+		Ordinal:  37,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BZ",
+				Name:        "America/Belize",
+			},
+		},
+	},
+	{
+		Code:     "BJ",
+		Name:     "Benin",
+		Ordinal:  25,
+		Synonyms: []string{"Dahomey"},
+		Zones: []Zone{
+			{
+				CountryCode: "BJ",
+				Name:        "Africa/Porto-Novo",
+			},
+		},
+	},
+	{
+		Code:     "BM",
+		Name:     "Bermuda",
+		Ordinal:  27,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BM",
+				Name:        "Atlantic/Bermuda",
+			},
+		},
+	},
+	{
+		Code:     "BT",
+		Name:     "Bhutan",
+		Ordinal:  33,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BT",
+				Name:        "Asia/Thimphu",
+			},
+		},
+	},
+	{
+		Code:     "BO",
+		Name:     "Bolivia (Plurinational State of)",
+		Ordinal:  29,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BO",
+				Name:        "America/La_Paz",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BQ",
+		Name:     "Bonaire, Sint Eustatius and Saba",
+		Ordinal:  30,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BQ",
+				Name:        "America/Kralendijk",
+			},
+		},
+	},
+	{
+		Code:     "BA",
+		Name:     "Bosnia and Herzegovina",
+		Ordinal:  17,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BA",
+				Name:        "Europe/Sarajevo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BW",
+		Name:     "Botswana",
+		Ordinal:  35,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BW",
+				Name:        "Africa/Gaborone",
+			},
+		},
+	},
+	{
+		Code:     "BV",
+		Name:     "Bouvet Island",
+		Ordinal:  34,
+		Synonyms: []string{},
+		Zones:    []Zone{},
+	},
+	{
+		Code:     "BR",
+		Name:     "Brazil",
+		Ordinal:  31,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BR",
+				Name:        "America/Araguaina",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Bahia",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Belem",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Boa_Vista",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Campo_Grande",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Cuiaba",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Eirunepe",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Fortaleza",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Maceio",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Manaus",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Noronha",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Porto_Velho",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Recife",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Rio_Branco",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Santarem",
+			},
+			{
+				CountryCode: "BR",
+				Name:        "America/Sao_Paulo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IO",
+		Name:     "British Indian Ocean Territory",
+		Ordinal:  106,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IO",
+				Name:        "Indian/Chagos",
+			},
+		},
+	},
+	{
+		Code:     "BN",
+		Name:     "Brunei Darussalam",
+		Ordinal:  28,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BN",
+				Name:        "Asia/Brunei",
+			},
+		},
+	},
+	{
+		Code:     "BG",
+		Name:     "Bulgaria",
+		Ordinal:  22,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BG",
+				Name:        "Europe/Sofia",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "BF",
+		Name:     "Burkina Faso",
+		Ordinal:  21,
+		Synonyms: []string{"Upper Volta"},
+		Zones: []Zone{
+			{
+				CountryCode: "BF",
+				Name:        "Africa/Ouagadougou",
+			},
+		},
+	},
+	{
+		Code:     "BI",
+		Name:     "Burundi",
+		Ordinal:  24,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BI",
+				Name:        "Africa/Bujumbura",
+			},
+		},
+	},
+	{
+		Code:     "CV",
+		Name:     "Cabo Verde",
+		Ordinal:  52,
+		Synonyms: []string{"Cape Verde"},
+		Zones: []Zone{
+			{
+				CountryCode: "CV",
+				Name:        "Atlantic/Cape_Verde",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "KH",
+		Name:     "Cambodia",
+		Ordinal:  117,
+		Synonyms: []string{"Kampuchea"},
+		Zones: []Zone{
+			{
+				CountryCode: "KH",
+				Name:        "Asia/Phnom_Penh",
+			},
+		},
+	},
+	{
+		Code:     "CM",
+		Name:     "Cameroon",
+		Ordinal:  47,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CM",
+				Name:        "Africa/Douala",
+			},
+		},
+	},
+	{
+		Code:     "CA",
+		Name:     "Canada",
+		Ordinal:  38,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CA",
+				Name:        "America/Atikokan",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Blanc-Sablon",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Cambridge_Bay",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Creston",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Dawson",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Dawson_Creek",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Edmonton",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Fort_Nelson",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Glace_Bay",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Goose_Bay",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Halifax",
+				Common:      true,
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Inuvik",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Iqaluit",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Moncton",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Nipigon",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Pangnirtung",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Rainy_River",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Rankin_Inlet",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Regina",
+				Common:      true,
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Resolute",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/St_Johns",
+				Common:      true,
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Swift_Current",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Thunder_Bay",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Toronto",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Vancouver",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Whitehorse",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Winnipeg",
+			},
+			{
+				CountryCode: "CA",
+				Name:        "America/Yellowknife",
+			},
+		},
+	},
+	{
+		Code:     "KY",
+		Name:     "Cayman Islands",
+		Ordinal:  124,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KY",
+				Name:        "America/Cayman",
+			},
+		},
+	},
+	{
+		Code:     "CF",
+		Name:     "Central African Republic",
+		Ordinal:  41,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CF",
+				Name:        "Africa/Bangui",
+			},
+		},
+	},
+	{
+		Code:     "TD",
+		Name:     "Chad",
+		Ordinal:  215,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TD",
+				Name:        "Africa/Ndjamena",
+			},
+		},
+	},
+	{
+		Code:     "CL",
+		Name:     "Chile",
+		Ordinal:  46,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CL",
+				Name:        "America/Punta_Arenas",
+			},
+			{
+				CountryCode: "CL",
+				Name:        "America/Santiago",
+				Common:      true,
+			},
+			{
+				CountryCode: "CL",
+				Name:        "Pacific/Easter",
+			},
+		},
+	},
+	{
+		Code:     "CN",
+		Name:     "China",
+		Ordinal:  48,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CN",
+				Name:        "Asia/Shanghai",
+				Common:      true,
+			},
+			{
+				CountryCode: "CN",
+				Name:        "Asia/Urumqi",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "CX",
+		Name:     "Christmas Island",
+		Ordinal:  54,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CX",
+				Name:        "Indian/Christmas",
+			},
+		},
+	},
+	{
+		Code:     "CC",
+		Name:     "Cocos (Keeling) Islands",
+		Ordinal:  39,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CC",
+				Name:        "Indian/Cocos",
+			},
+		},
+	},
+	{
+		Code:     "CO",
+		Name:     "Colombia",
+		Ordinal:  49,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CO",
+				Name:        "America/Bogota",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "KM",
+		Name:     "Comoros",
+		Ordinal:  119,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KM",
+				Name:        "Indian/Comoro",
+			},
+		},
+	},
+	{
+		Code:     "CG",
+		Name:     "Congo",
+		Ordinal:  42,
+		Synonyms: []string{"Republic of the Congo", "Congo-Brazzaville"},
+		Zones: []Zone{
+			{
+				CountryCode: "CG",
+				Name:        "Africa/Brazzaville",
+			},
+		},
+	},
+	{
+		Code:     "CD",
+		Name:     "Congo, Democratic Republic of the",
+		Ordinal:  40,
+		Synonyms: []string{"DR Congo", "DRC", "Congo-Kinshasa", "Zaire"},
+		Zones: []Zone{
+			{
+				CountryCode: "CD",
+				Name:        "Africa/Kinshasa",
+			},
+			{
+				CountryCode: "CD",
+				Name:        "Africa/Lubumbashi",
+			},
+		},
+	},
+	{
+		Code:     "CK",
+		Name:     "Cook Islands",
+		Ordinal:  45,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CK",
+				Name:        "Pacific/Rarotonga",
+			},
+		},
+	},
+	{
+		Code:     "CR",
+		Name:     "Costa Rica",
+		Ordinal:  50,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CR",
+				Name:        "America/Costa_Rica",
+			},
+		},
+	},
+	{
+		Code:     "HR",
+		Name:     "Croatia",
+		Ordinal:  98,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "HR",
+				Name:        "Europe/Zagreb",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "CU",
+		Name:     "Cuba",
+		Ordinal:  51,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CU",
+				Name:        "America/Havana",
+			},
+		},
+	},
+	{
+		Code:     "CW",
+		Name:     "Curaçao",
+		Ordinal:  53,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CW",
+				Name:        "America/Curacao",
+			},
+		},
+	},
+	{
+		Code:     "CY",
+		Name:     "Cyprus",
+		Ordinal:  55,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CY",
+				Name:        "Asia/Famagusta",
+			},
+			{
+				CountryCode: "CY",
+				Name:        "Asia/Nicosia",
+			},
+		},
+	},
+	{
+		Code:     "CZ",
+		Name:     "Czechia",
+		Ordinal:  56,
+		Synonyms: []string{"Czech Republic"},
+		Zones: []Zone{
+			{
+				CountryCode: "CZ",
+				Name:        "Europe/Prague",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "CI",
+		Name:     "Côte d'Ivoire",
+		Ordinal:  44,
+		Synonyms: []string{"Ivory Coast"},
+		Zones: []Zone{
+			{
+				CountryCode: "CI",
+				Name:        "Africa/Abidjan",
+			},
+		},
+	},
+	{
+		Code:     "DK",
+		Name:     "Denmark",
+		Ordinal:  59,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DK",
+				Name:        "Europe/Copenhagen",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "DJ",
+		Name:     "Djibouti",
+		Ordinal:  58,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DJ",
+				Name:        "Africa/Djibouti",
+			},
+		},
+	},
+	{
+		Code:     "DM",
+		Name:     "Dominica",
+		Ordinal:  60,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DM",
+				Name:        "America/Dominica",
+			},
+		},
+	},
+	{
+		Code:     "DO",
+		Name:     "Dominican Republic",
+		Ordinal:  61,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DO",
+				Name:        "America/Santo_Domingo",
+			},
+		},
+	},
+	{
+		Code:     "EC",
+		Name:     "Ecuador",
+		Ordinal:  63,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "EC",
+				Name:        "America/Guayaquil",
+			},
+			{
+				CountryCode: "EC",
+				Name:        "Pacific/Galapagos",
+			},
+		},
+	},
+	{
+		Code:     "EG",
+		Name:     "Egypt",
+		Ordinal:  65,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "EG",
+				Name:        "Africa/Cairo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SV",
+		Name:     "El Salvador",
+		Ordinal:  210,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SV",
+				Name:        "America/El_Salvador",
+			},
+		},
+	},
+	{
+		Code:     "GQ",
+		Name:     "Equatorial Guinea",
+		Ordinal:  88,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GQ",
+				Name:        "Africa/Malabo",
+			},
+		},
+	},
+	{
+		Code:     "ER",
+		Name:     "Eritrea",
+		Ordinal:  67,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ER",
+				Name:        "Africa/Asmara",
+			},
+		},
+	},
+	{
+		Code:     "EE",
+		Name:     "Estonia",
+		Ordinal:  64,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "EE",
+				Name:        "Europe/Tallinn",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SZ",
+		Name:     "Eswatini",
+		Ordinal:  213,
+		Synonyms: []string{"Swaziland"},
+		Zones: []Zone{
+			{
+				CountryCode: "SZ",
+				Name:        "Africa/Mbabane",
+			},
+		},
+	},
+	{
+		Code:     "ET",
+		Name:     "Ethiopia",
+		Ordinal:  69,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ET",
+				Name:        "Africa/Addis_Ababa",
+			},
+		},
+	},
+	{
+		Code:     "FK",
+		Name:     "Falkland Islands (Malvinas)",
+		Ordinal:  72,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FK",
+				Name:        "Atlantic/Stanley",
+			},
+		},
+	},
+	{
+		Code:     "FO",
+		Name:     "Faroe Islands",
+		Ordinal:  74,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FO",
+				Name:        "Atlantic/Faroe",
+			},
+		},
+	},
+	{
+		Code:     "FJ",
+		Name:     "Fiji",
+		Ordinal:  71,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FJ",
+				Name:        "Pacific/Fiji",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "FI",
+		Name:     "Finland",
+		Ordinal:  70,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FI",
+				Name:        "Europe/Helsinki",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "FR",
+		Name:     "France",
+		Ordinal:  75,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FR",
+				Name:        "Europe/Paris",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "GF",
+		Name:     "French Guiana",
+		Ordinal:  80,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GF",
+				Name:        "America/Cayenne",
+			},
+		},
+	},
+	{
+		Code:     "PF",
+		Name:     "French Polynesia",
+		Ordinal:  175,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PF",
+				Name:        "Pacific/Gambier",
+			},
+			{
+				CountryCode: "PF",
+				Name:        "Pacific/Marquesas",
+			},
+			{
+				CountryCode: "PF",
+				Name:        "Pacific/Tahiti",
+			},
+		},
+	},
+	{
+		Code:     "TF",
+		Name:     "French Southern Territories",
+		Ordinal:  216,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TF",
+				Name:        "Indian/Kerguelen",
+			},
+		},
+	},
+	{
+		Code:     "GA",
+		Name:     "Gabon",
+		Ordinal:  76,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GA",
+				Name:        "Africa/Libreville",
+			},
+		},
+	},
+	{
+		Code:     "GM",
+		Name:     "Gambia",
+		Ordinal:  85,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GM",
+				Name:        "Africa/Banjul",
+			},
+		},
+	},
+	{
+		Code:     "GE",
+		Name:     "Georgia",
+		Ordinal:  79,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GE",
+				Name:        "Asia/Tbilisi",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "DE",
+		Name:     "Germany",
+		Ordinal:  57,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "DE",
+				Name:        "Europe/Berlin",
+				Common:      true,
+			},
+			{
+				CountryCode: "DE",
+				Name:        "Europe/Busingen",
+			},
+		},
+	},
+	{
+		Code:     "GH",
+		Name:     "Ghana",
+		Ordinal:  82,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GH",
+				Name:        "Africa/Accra",
+			},
+		},
+	},
+	{
+		Code:     "GI",
+		Name:     "Gibraltar",
+		Ordinal:  83,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GI",
+				Name:        "Europe/Gibraltar",
+			},
+		},
+	},
+	{
+		Code:     "GR",
+		Name:     "Greece",
+		Ordinal:  89,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GR",
+				Name:        "Europe/Athens",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "GL",
+		Name:     "Greenland",
+		Ordinal:  84,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GL",
+				Name:        "America/Danmarkshavn",
+			},
+			{
+				CountryCode: "GL",
+				Name:        "America/Nuuk",
+				Common:      true,
+			},
+			{
+				CountryCode: "GL",
+				Name:        "America/Scoresbysund",
+			},
+			{
+				CountryCode: "GL",
+				Name:        "America/Thule",
+			},
+		},
+	},
+	{
+		Code:     "GD",
+		Name:     "Grenada",
+		Ordinal:  78,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GD",
+				Name:        "America/Grenada",
+			},
+		},
+	},
+	{
+		Code:     "GP",
+		Name:     "Guadeloupe",
+		Ordinal:  87,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GP",
+				Name:        "America/Guadeloupe",
+			},
+		},
+	},
+	{
+		Code:     "GU",
+		Name:     "Guam",
+		Ordinal:  92,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GU",
+				Name:        "Pacific/Guam",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "GT",
+		Name:     "Guatemala",
+		Ordinal:  91,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GT",
+				Name:        "America/Guatemala",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "GG",
+		Name:     "Guernsey",
+		Ordinal:  81,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GG",
+				Name:        "Europe/Guernsey",
+			},
+		},
+	},
+	{
+		Code:     "GN",
+		Name:     "Guinea",
+		Ordinal:  86,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GN",
+				Name:        "Africa/Conakry",
+			},
+		},
+	},
+	{
+		Code:     "GW",
+		Name:     "Guinea-Bissau",
+		Ordinal:  93,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GW",
+				Name:        "Africa/Bissau",
+			},
+		},
+	},
+	{
+		Code:     "GY",
+		Name:     "Guyana",
+		Ordinal:  94,
+		Synonyms: []string{"British Guiana"},
+		Zones: []Zone{
+			{
+				CountryCode: "GY",
+				Name:        "America/Guyana",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "HT",
+		Name:     "Haiti",
+		Ordinal:  99,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "HT",
+				Name:        "America/Port-au-Prince",
+			},
+		},
+	},
+	{
+		Code:     "HM",
+		Name:     "Heard Island and McDonald Islands",
+		Ordinal:  96,
+		Synonyms: []string{},
+		Zones:    []Zone{},
+	},
+	{
+		Code:     "VA",
+		Name:     "Holy See",
+		Ordinal:  236,
+		Synonyms: []string{"Vatican", "Vatican City"},
+		Zones: []Zone{
+			{
+				CountryCode: "VA",
+				Name:        "Europe/Vatican",
+			},
+		},
+	},
+	{
+		Code:     "HN",
+		Name:     "Honduras",
+		Ordinal:  97,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "HN",
+				Name:        "America/Tegucigalpa",
+			},
+		},
+	},
+	{
+		Code:     "HK",
+		Name:     "Hong Kong",
+		Ordinal:  95,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "HK",
+				Name:        "Asia/Hong_Kong",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "HU",
+		Name:     "Hungary",
+		Ordinal:  100,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "HU",
+				Name:        "Europe/Budapest",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IS",
+		Name:     "Iceland",
+		Ordinal:  109,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IS",
+				Name:        "Atlantic/Reykjavik",
+			},
+		},
+	},
+	{
+		Code:     "IN",
+		Name:     "India",
+		Ordinal:  105,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IN",
+				Name:        "Asia/Kolkata",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "ID",
+		Name:     "Indonesia",
+		Ordinal:  101,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ID",
+				Name:        "Asia/Jakarta",
+				Common:      true,
+			},
+			{
+				CountryCode: "ID",
+				Name:        "Asia/Jayapura",
+			},
+			{
+				CountryCode: "ID",
+				Name:        "Asia/Makassar",
+			},
+			{
+				CountryCode: "ID",
+				Name:        "Asia/Pontianak",
+			},
+		},
+	},
+	{
+		Code:     "IR",
+		Name:     "Iran (Islamic Republic of)",
+		Ordinal:  108,
+		Synonyms: []string{"Persia"},
+		Zones: []Zone{
+			{
+				CountryCode: "IR",
+				Name:        "Asia/Tehran",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IQ",
+		Name:     "Iraq",
+		Ordinal:  107,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IQ",
+				Name:        "Asia/Baghdad",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IE",
+		Name:     "Ireland",
+		Ordinal:  102,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IE",
+				Name:        "Europe/Dublin",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IM",
+		Name:     "Isle of Man",
+		Ordinal:  104,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IM",
+				Name:        "Europe/Isle_of_Man",
+			},
+		},
+	},
+	{
+		Code:     "IL",
+		Name:     "Israel",
+		Ordinal:  103,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IL",
+				Name:        "Asia/Jerusalem",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "IT",
+		Name:     "Italy",
+		Ordinal:  110,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "IT",
+				Name:        "Europe/Rome",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "JM",
+		Name:     "Jamaica",
+		Ordinal:  112,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "JM",
+				Name:        "America/Jamaica",
+			},
+		},
+	},
+	{
+		Code:     "JP",
+		Name:     "Japan",
+		Ordinal:  114,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "JP",
+				Name:        "Asia/Tokyo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "JE",
+		Name:     "Jersey",
+		Ordinal:  111,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "JE",
+				Name:        "Europe/Jersey",
+			},
+		},
+	},
+	{
+		Code:     "JO",
+		Name:     "Jordan",
+		Ordinal:  113,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "JO",
+				Name:        "Asia/Amman",
+			},
+		},
+	},
+	{
+		Code:     "KZ",
+		Name:     "Kazakhstan",
+		Ordinal:  125,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Almaty",
+				Common:      true,
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Aqtau",
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Aqtobe",
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Atyrau",
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Oral",
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Qostanay",
+			},
+			{
+				CountryCode: "KZ",
+				Name:        "Asia/Qyzylorda",
+			},
+		},
+	},
+	{
+		Code:     "KE",
+		Name:     "Kenya",
+		Ordinal:  115,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KE",
+				Name:        "Africa/Nairobi",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "KI",
+		Name:     "Kiribati",
+		Ordinal:  118,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KI",
+				Name:        "Pacific/Kanton",
+			},
+			{
+				CountryCode: "KI",
+				Name:        "Pacific/Kiritimati",
+			},
+			{
+				CountryCode: "KI",
+				Name:        "Pacific/Tarawa",
+			},
+		},
+	},
+	{
+		Code:     "KP",
+		Name:     "Korea (Democratic People's Republic of)",
+		Ordinal:  121,
+		Synonyms: []string{"North Korea"},
+		Zones: []Zone{
+			{
+				CountryCode: "KP",
+				Name:        "Asia/Pyongyang",
+			},
+		},
+	},
+	{
+		Code:     "KR",
+		Name:     "Korea, Republic of",
+		Ordinal:  122,
+		Synonyms: []string{"South Korea"},
+		Zones: []Zone{
+			{
+				CountryCode: "KR",
+				Name:        "Asia/Seoul",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "KW",
+		Name:     "Kuwait",
+		Ordinal:  123,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KW",
+				Name:        "Asia/Kuwait",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "KG",
+		Name:     "Kyrgyzstan",
+		Ordinal:  116,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "KG",
+				Name:        "Asia/Bishkek",
+			},
+		},
+	},
+	{
+		Code:     "LA",
+		Name:     "Lao People's Democratic Republic",
+		Ordinal:  126,
+		Synonyms: []string{"Laos"},
+		Zones: []Zone{
+			{
+				CountryCode: "LA",
+				Name:        "Asia/Vientiane",
+			},
+		},
+	},
+	{
+		Code:     "LV",
+		Name:     "Latvia",
+		Ordinal:  135,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LV",
+				Name:        "Europe/Riga",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "LB",
+		Name:     "Lebanon",
+		Ordinal:  127,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LB",
+				Name:        "Asia/Beirut",
+			},
+		},
+	},
+	{
+		Code:     "LS",
+		Name:     "Lesotho",
+		Ordinal:  132,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LS",
+				Name:        "Africa/Maseru",
+			},
+		},
+	},
+	{
+		Code:     "LR",
+		Name:     "Liberia",
+		Ordinal:  131,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LR",
+				Name:        "Africa/Monrovia",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "LY",
+		Name:     "Libya",
+		Ordinal:  136,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LY",
+				Name:        "Africa/Tripoli",
+			},
+		},
+	},
+	{
+		Code:     "LI",
+		Name:     "Liechtenstein",
+		Ordinal:  129,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LI",
+				Name:        "Europe/Vaduz",
+			},
+		},
+	},
+	{
+		Code:     "LT",
+		Name:     "Lithuania",
+		Ordinal:  133,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LT",
+				Name:        "Europe/Vilnius",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "LU",
+		Name:     "Luxembourg",
+		Ordinal:  134,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "LU",
+				Name:        "Europe/Luxembourg",
+			},
+		},
+	},
+	{
+		Code:     "MO",
+		Name:     "Macao",
+		Ordinal:  148,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MO",
+				Name:        "Asia/Macau",
+			},
+		},
+	},
+	{
+		Code:     "MG",
+		Name:     "Madagascar",
+		Ordinal:  142,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MG",
+				Name:        "Indian/Antananarivo",
+			},
+		},
+	},
+	{
+		Code:     "MW",
+		Name:     "Malawi",
+		Ordinal:  156,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MW",
+				Name:        "Africa/Blantyre",
+			},
+		},
+	},
+	{
+		Code:     "MY",
+		Name:     "Malaysia",
+		Ordinal:  158,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MY",
+				Name:        "Asia/Kuala_Lumpur",
+				Common:      true,
+			},
+			{
+				CountryCode: "MY",
+				Name:        "Asia/Kuching",
+			},
+		},
+	},
+	{
+		Code:     "MV",
+		Name:     "Maldives",
+		Ordinal:  155,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MV",
+				Name:        "Indian/Maldives",
+			},
+		},
+	},
+	{
+		Code:     "ML",
+		Name:     "Mali",
+		Ordinal:  145,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ML",
+				Name:        "Africa/Bamako",
+			},
+		},
+	},
+	{
+		Code:     "MT",
+		Name:     "Malta",
+		Ordinal:  153,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MT",
+				Name:        "Europe/Malta",
+			},
+		},
+	},
+	{
+		Code:     "MH",
+		Name:     "Marshall Islands",
+		Ordinal:  143,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MH",
+				Name:        "Pacific/Kwajalein",
+			},
+			{
+				CountryCode: "MH",
+				Name:        "Pacific/Majuro",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "MQ",
+		Name:     "Martinique",
+		Ordinal:  150,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MQ",
+				Name:        "America/Martinique",
+			},
+		},
+	},
+	{
+		Code:     "MR",
+		Name:     "Mauritania",
+		Ordinal:  151,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MR",
+				Name:        "Africa/Nouakchott",
+			},
+		},
+	},
+	{
+		Code:     "MU",
+		Name:     "Mauritius",
+		Ordinal:  154,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MU",
+				Name:        "Indian/Mauritius",
+			},
+		},
+	},
+	{
+		Code:     "YT",
+		Name:     "Mayotte",
+		Ordinal:  246,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "YT",
+				Name:        "Indian/Mayotte",
+			},
+		},
+	},
+	{
+		Code:     "MX",
+		Name:     "Mexico",
+		Ordinal:  157,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MX",
+				Name:        "America/Bahia_Banderas",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Cancun",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Chihuahua",
+				Common:      true,
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Hermosillo",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Matamoros",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Mazatlan",
+				Common:      true,
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Merida",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Mexico_City",
+				Common:      true,
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Monterrey",
+				Common:      true,
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Ojinaga",
+			},
+			{
+				CountryCode: "MX",
+				Name:        "America/Tijuana",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "FM",
+		Name:     "Micronesia (Federated States of)",
+		Ordinal:  73,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "FM",
+				Name:        "Pacific/Chuuk",
+			},
+			{
+				CountryCode: "FM",
+				Name:        "Pacific/Kosrae",
+			},
+			{
+				CountryCode: "FM",
+				Name:        "Pacific/Pohnpei",
+			},
+		},
+	},
+	{
+		Code:     "MD",
+		Name:     "Moldova, Republic of",
+		Ordinal:  139,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MD",
+				Name:        "Europe/Chisinau",
+			},
+		},
+	},
+	{
+		Code:     "MC",
+		Name:     "Monaco",
+		Ordinal:  138,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MC",
+				Name:        "Europe/Monaco",
+			},
+		},
+	},
+	{
+		Code:     "MN",
+		Name:     "Mongolia",
+		Ordinal:  147,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MN",
+				Name:        "Asia/Choibalsan",
+			},
+			{
+				CountryCode: "MN",
+				Name:        "Asia/Hovd",
+			},
+			{
+				CountryCode: "MN",
+				Name:        "Asia/Ulaanbaatar",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "ME",
+		Name:     "Montenegro",
+		Ordinal:  140,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ME",
+				Name:        "Europe/Podgorica",
+			},
+		},
+	},
+	{
+		Code:     "MS",
+		Name:     "Montserrat",
+		Ordinal:  152,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MS",
+				Name:        "America/Montserrat",
+			},
+		},
+	},
+	{
+		Code:     "MA",
+		Name:     "Morocco",
+		Ordinal:  137,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MA",
+				Name:        "Africa/Casablanca",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "MZ",
+		Name:     "Mozambique",
+		Ordinal:  159,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MZ",
+				Name:        "Africa/Maputo",
+			},
+		},
+	},
+	{
+		Code:     "MM",
+		Name:     "Myanmar",
+		Ordinal:  146,
+		Synonyms: []string{"Burma"},
+		Zones: []Zone{
+			{
+				CountryCode: "MM",
+				Name:        "Asia/Yangon",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "NA",
+		Name:     "Namibia",
+		Ordinal:  160,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NA",
+				Name:        "Africa/Windhoek",
+			},
+		},
+	},
+	{
+		Code:     "NR",
+		Name:     "Nauru",
+		Ordinal:  169,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NR",
+				Name:        "Pacific/Nauru",
+			},
+		},
+	},
+	{
+		Code:     "NP",
+		Name:     "Nepal",
+		Ordinal:  168,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NP",
+				Name:        "Asia/Kathmandu",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "NL",
+		Name:     "Netherlands",
+		Ordinal:  166,
+		Synonyms: []string{"Holland"},
+		Zones: []Zone{
+			{
+				CountryCode: "NL",
+				Name:        "Europe/Amsterdam",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "NC",
+		Name:     "New Caledonia",
+		Ordinal:  161,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NC",
+				Name:        "Pacific/Noumea",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "NZ",
+		Name:     "New Zealand",
+		Ordinal:  171,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NZ",
+				Name:        "Pacific/Auckland",
+				Common:      true,
+			},
+			{
+				CountryCode: "NZ",
+				Name:        "Pacific/Chatham",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "NI",
+		Name:     "Nicaragua",
+		Ordinal:  165,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NI",
+				Name:        "America/Managua",
+			},
+		},
+	},
+	{
+		Code:     "NE",
+		Name:     "Niger",
+		Ordinal:  162,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NE",
+				Name:        "Africa/Niamey",
+			},
+		},
+	},
+	{
+		Code:     "NG",
+		Name:     "Nigeria",
+		Ordinal:  164,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NG",
+				Name:        "Africa/Lagos",
+			},
+		},
+	},
+	{
+		Code:     "NU",
+		Name:     "Niue",
+		Ordinal:  170,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NU",
+				Name:        "Pacific/Niue",
+			},
+		},
+	},
+	{
+		Code:     "NF",
+		Name:     "Norfolk Island",
+		Ordinal:  163,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NF",
+				Name:        "Pacific/Norfolk",
+			},
+		},
+	},
+	{
+		Code:     "MK",
+		Name:     "North Macedonia",
+		Ordinal:  144,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MK",
+				Name:        "Europe/Skopje",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "MP",
+		Name:     "Northern Mariana Islands",
+		Ordinal:  149,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MP",
+				Name:        "Pacific/Saipan",
+			},
+		},
+	},
+	{
+		Code:     "NO",
+		Name:     "Norway",
+		Ordinal:  167,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "NO",
+				Name:        "Europe/Oslo",
+			},
+		},
+	},
+	{
+		Code:     "OM",
+		Name:     "Oman",
+		Ordinal:  172,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "OM",
+				Name:        "Asia/Muscat",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PK",
+		Name:     "Pakistan",
+		Ordinal:  178,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PK",
+				Name:        "Asia/Karachi",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PW",
+		Name:     "Palau",
+		Ordinal:  185,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PW",
+				Name:        "Pacific/Palau",
+			},
+		},
+	},
+	{
+		Code:     "PS",
+		Name:     "Palestine, State of",
+		Ordinal:  183,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PS",
+				Name:        "Asia/Gaza",
+			},
+			{
+				CountryCode: "PS",
+				Name:        "Asia/Hebron",
+			},
+		},
+	},
+	{
+		Code:     "PA",
+		Name:     "Panama",
+		Ordinal:  173,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PA",
+				Name:        "America/Panama",
+			},
+		},
+	},
+	{
+		Code:     "PG",
+		Name:     "Papua New Guinea",
+		Ordinal:  176,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PG",
+				Name:        "Pacific/Bougainville",
+			},
+			{
+				CountryCode: "PG",
+				Name:        "Pacific/Port_Moresby",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PY",
+		Name:     "Paraguay",
+		Ordinal:  186,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PY",
+				Name:        "America/Asuncion",
+			},
+		},
+	},
+	{
+		Code:     "PE",
+		Name:     "Peru",
+		Ordinal:  174,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PE",
+				Name:        "America/Lima",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PH",
+		Name:     "Philippines",
+		Ordinal:  177,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PH",
+				Name:        "Asia/Manila",
+			},
+		},
+	},
+	{
+		Code:     "PN",
+		Name:     "Pitcairn",
+		Ordinal:  181,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PN",
+				Name:        "Pacific/Pitcairn",
+			},
+		},
+	},
+	{
+		Code:     "PL",
+		Name:     "Poland",
+		Ordinal:  179,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PL",
+				Name:        "Europe/Warsaw",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PT",
+		Name:     "Portugal",
+		Ordinal:  184,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PT",
+				Name:        "Atlantic/Azores",
+				Common:      true,
+			},
+			{
+				CountryCode: "PT",
+				Name:        "Atlantic/Madeira",
+			},
+			{
+				CountryCode: "PT",
+				Name:        "Europe/Lisbon",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "PR",
+		Name:     "Puerto Rico",
+		Ordinal:  182,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PR",
+				Name:        "America/Puerto_Rico",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "QA",
+		Name:     "Qatar",
+		Ordinal:  187,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "QA",
+				Name:        "Asia/Qatar",
+			},
+		},
+	},
+	{
+		Code:     "RO",
+		Name:     "Romania",
+		Ordinal:  189,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "RO",
+				Name:        "Europe/Bucharest",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "RU",
+		Name:     "Russian Federation",
+		Ordinal:  191,
+		Synonyms: []string{"Russia"},
+		Zones: []Zone{
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Anadyr",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Barnaul",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Chita",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Irkutsk",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Kamchatka",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Khandyga",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Krasnoyarsk",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Magadan",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Novokuznetsk",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Novosibirsk",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Omsk",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Sakhalin",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Srednekolymsk",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Tomsk",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Ust-Nera",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Vladivostok",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Yakutsk",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Asia/Yekaterinburg",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Astrakhan",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Kaliningrad",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Kirov",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Moscow",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Samara",
+				Common:      true,
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Saratov",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Ulyanovsk",
+			},
+			{
+				CountryCode: "RU",
+				Name:        "Europe/Volgograd",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "RW",
+		Name:     "Rwanda",
+		Ordinal:  192,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "RW",
+				Name:        "Africa/Kigali",
+			},
+		},
+	},
+	{
+		Code:     "RE",
+		Name:     "Réunion",
+		Ordinal:  188,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "RE",
+				Name:        "Indian/Reunion",
+			},
+		},
+	},
+	{
+		Code:     "BL",
+		Name:     "Saint Barthélemy",
+		Ordinal:  26,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "BL",
+				Name:        "America/St_Barthelemy",
+			},
+		},
+	},
+	{
+		Code:     "SH",
+		Name:     "Saint Helena, Ascension and Tristan da Cunha",
+		Ordinal:  199,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SH",
+				Name:        "Atlantic/St_Helena",
+			},
+		},
+	},
+	{
+		Code:     "KN",
+		Name:     "Saint Kitts and Nevis",
+		Ordinal:  120,
+		Synonyms: []string{"St Kitts and Nevis"},
+		Zones: []Zone{
+			{
+				CountryCode: "KN",
+				Name:        "America/St_Kitts",
+			},
+		},
+	},
+	{
+		Code:     "LC",
+		Name:     "Saint Lucia",
+		Ordinal:  128,
+		Synonyms: []string{"St Lucia"},
+		Zones: []Zone{
+			{
+				CountryCode: "LC",
+				Name:        "America/St_Lucia",
+			},
+		},
+	},
+	{
+		Code:     "MF",
+		Name:     "Saint Martin (French part)",
+		Ordinal:  141,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "MF",
+				Name:        "America/Marigot",
+			},
+		},
+	},
+	{
+		Code:     "PM",
+		Name:     "Saint Pierre and Miquelon",
+		Ordinal:  180,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "PM",
+				Name:        "America/Miquelon",
+			},
+		},
+	},
+	{
+		Code:     "VC",
+		Name:     "Saint Vincent and the Grenadines",
+		Ordinal:  237,
+		Synonyms: []string{"St Vincent and the Grenadines"},
+		Zones: []Zone{
+			{
+				CountryCode: "VC",
+				Name:        "America/St_Vincent",
+			},
+		},
+	},
+	{
+		Code:     "WS",
+		Name:     "Samoa",
+		Ordinal:  244,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "WS",
+				Name:        "Pacific/Apia",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SM",
+		Name:     "San Marino",
+		Ordinal:  204,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SM",
+				Name:        "Europe/San_Marino",
+			},
+		},
+	},
+	{
+		Code:     "ST",
+		Name:     "Sao Tome and Principe",
+		Ordinal:  209,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ST",
+				Name:        "Africa/Sao_Tome",
+			},
+		},
+	},
+	{
+		Code:     "SA",
+		Name:     "Saudi Arabia",
+		Ordinal:  193,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SA",
+				Name:        "Asia/Riyadh",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SN",
+		Name:     "Senegal",
+		Ordinal:  205,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SN",
+				Name:        "Africa/Dakar",
+			},
+		},
+	},
+	{
+		Code:     "RS",
+		Name:     "Serbia",
+		Ordinal:  190,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "RS",
+				Name:        "Europe/Belgrade",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SC",
+		Name:     "Seychelles",
+		Ordinal:  195,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SC",
+				Name:        "Indian/Mahe",
+			},
+		},
+	},
+	{
+		Code:     "SL",
+		Name:     "Sierra Leone",
+		Ordinal:  203,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SL",
+				Name:        "Africa/Freetown",
+			},
+		},
+	},
+	{
+		Code:     "SG",
+		Name:     "Singapore",
+		Ordinal:  198,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SG",
+				Name:        "Asia/Singapore",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SX",
+		Name:     "Sint Maarten (Dutch part)",
+		Ordinal:  211,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SX",
+				Name:        "America/Lower_Princes",
+			},
+		},
+	},
+	{
+		Code:     "SK",
+		Name:     "Slovakia",
+		Ordinal:  202,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SK",
+				Name:        "Europe/Bratislava",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SI",
+		Name:     "Slovenia",
+		Ordinal:  200,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SI",
+				Name:        "Europe/Ljubljana",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SB",
+		Name:     "Solomon Islands",
+		Ordinal:  194,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SB",
+				Name:        "Pacific/Guadalcanal",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SO",
+		Name:     "Somalia",
+		Ordinal:  206,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SO",
+				Name:        "Africa/Mogadishu",
+			},
+		},
+	},
+	{
+		Code:     "ZA",
+		Name:     "South Africa",
+		Ordinal:  247,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ZA",
+				Name:        "Africa/Johannesburg",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "GS",
+		Name:     "South Georgia and the South Sandwich Islands",
+		Ordinal:  90,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "GS",
+				Name:        "Atlantic/South_Georgia",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SS",
+		Name:     "South Sudan",
+		Ordinal:  208,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SS",
+				Name:        "Africa/Juba",
+			},
+		},
+	},
+	{
+		Code:     "ES",
+		Name:     "Spain",
+		Ordinal:  68,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ES",
+				Name:        "Africa/Ceuta",
+			},
+			{
+				CountryCode: "ES",
+				Name:        "Atlantic/Canary",
+			},
+			{
+				CountryCode: "ES",
+				Name:        "Europe/Madrid",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "LK",
+		Name:     "Sri Lanka",
+		Ordinal:  130,
+		Synonyms: []string{"Ceylon"},
+		Zones: []Zone{
+			{
+				CountryCode: "LK",
+				Name:        "Asia/Colombo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SD",
+		Name:     "Sudan",
+		Ordinal:  196,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SD",
+				Name:        "Africa/Khartoum",
+			},
+		},
+	},
+	{
+		Code:     "SR",
+		Name:     "Suriname",
+		Ordinal:  207,
+		Synonyms: []string{"Dutch Guiana"},
+		Zones: []Zone{
+			{
+				CountryCode: "SR",
+				Name:        "America/Paramaribo",
+			},
+		},
+	},
+	{
+		Code:     "SJ",
+		Name:     "Svalbard and Jan Mayen",
+		Ordinal:  201,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SJ",
+				Name:        "Arctic/Longyearbyen",
+			},
+		},
+	},
+	{
+		Code:     "SE",
+		Name:     "Sweden",
+		Ordinal:  197,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "SE",
+				Name:        "Europe/Stockholm",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "CH",
+		Name:     "Switzerland",
+		Ordinal:  43,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "CH",
+				Name:        "Europe/Zurich",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "SY",
+		Name:     "Syrian Arab Republic",
+		Ordinal:  212,
+		Synonyms: []string{"Syria"},
+		Zones: []Zone{
+			{
+				CountryCode: "SY",
+				Name:        "Asia/Damascus",
+			},
+		},
+	},
+	{
+		Code:     "TW",
+		Name:     "Taiwan, Province of China",
+		Ordinal:  228,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TW",
+				Name:        "Asia/Taipei",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "TJ",
+		Name:     "Tajikistan",
+		Ordinal:  219,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TJ",
+				Name:        "Asia/Dushanbe",
+			},
+		},
+	},
+	{
+		Code:     "TZ",
+		Name:     "Tanzania, United Republic of",
+		Ordinal:  229,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TZ",
+				Name:        "Africa/Dar_es_Salaam",
+			},
+		},
+	},
+	{
+		Code:     "TH",
+		Name:     "Thailand",
+		Ordinal:  218,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TH",
+				Name:        "Asia/Bangkok",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "TL",
+		Name:     "Timor-Leste",
+		Ordinal:  221,
+		Synonyms: []string{"East Timor"},
+		Zones: []Zone{
+			{
+				CountryCode: "TL",
+				Name:        "Asia/Dili",
+			},
+		},
+	},
+	{
+		Code:     "TG",
+		Name:     "Togo",
+		Ordinal:  217,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TG",
+				Name:        "Africa/Lome",
+			},
+		},
+	},
+	{
+		Code:     "TK",
+		Name:     "Tokelau",
+		Ordinal:  220,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TK",
+				Name:        "Pacific/Fakaofo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "TO",
+		Name:     "Tonga",
+		Ordinal:  224,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TO",
+				Name:        "Pacific/Tongatapu",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "TT",
+		Name:     "Trinidad and Tobago",
+		Ordinal:  226,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TT",
+				Name:        "America/Port_of_Spain",
+			},
+		},
+	},
+	{
+		Code:     "TN",
+		Name:     "Tunisia",
+		Ordinal:  223,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TN",
+				Name:        "Africa/Tunis",
+			},
+		},
+	},
+	{
+		Code:     "TR",
+		Name:     "Turkey",
+		Ordinal:  225,
+		Synonyms: []string{"Türkiye"},
+		Zones: []Zone{
+			{
+				CountryCode: "TR",
+				Name:        "Europe/Istanbul",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "TM",
+		Name:     "Turkmenistan",
+		Ordinal:  222,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TM",
+				Name:        "Asia/Ashgabat",
+			},
+		},
+	},
+	{
+		Code:     "TC",
+		Name:     "Turks and Caicos Islands",
+		Ordinal:  214,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TC",
+				Name:        "America/Grand_Turk",
+			},
+		},
+	},
+	{
+		Code:     "TV",
+		Name:     "Tuvalu",
+		Ordinal:  227,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "TV",
+				Name:        "Pacific/Funafuti",
+			},
+		},
+	},
+	{
+		Code:     "UG",
+		Name:     "Uganda",
+		Ordinal:  231,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "UG",
+				Name:        "Africa/Kampala",
+			},
+		},
+	},
+	{
+		Code:     "UA",
+		Name:     "Ukraine",
+		Ordinal:  230,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "UA",
+				Name:        "Europe/Kiev",
+				Common:      true,
+			},
+			{
+				CountryCode: "UA",
+				Name:        "Europe/Simferopol",
+			},
+			{
+				CountryCode: "UA",
+				Name:        "Europe/Uzhgorod",
+			},
+			{
+				CountryCode: "UA",
+				Name:        "Europe/Zaporozhye",
+			},
+		},
+	},
+	{
+		Code:     "AE",
+		Name:     "United Arab Emirates",
+		Ordinal:  2,
+		Synonyms: []string{"UAE"},
+		Zones: []Zone{
+			{
+				CountryCode: "AE",
+				Name:        "Asia/Dubai",
+			},
+		},
+	},
+	{
+		Code:     "GB",
+		Name:     "United Kingdom of Great Britain and Northern Ireland",
+		Ordinal:  77,
+		Synonyms: []string{"UK", "Great Britain", "Britain", "England", "Scotland", "Wales", "Northern Ireland"},
+		Zones: []Zone{
+			{
+				CountryCode: "GB",
+				Name:        "Europe/London",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "UM",
+		Name:     "United States Minor Outlying Islands",
+		Ordinal:  232,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "UM",
+				Name:        "Pacific/Midway",
+				Common:      true,
+			},
+			{
+				CountryCode: "UM",
+				Name:        "Pacific/Wake",
+			},
+		},
+	},
+	{
+		Code:     "US",
+		Name:     "United States of America",
+		Ordinal:  233,
+		Synonyms: []string{"USA", "United States"},
+		Zones: []Zone{
+			{
+				CountryCode: "US",
+				Name:        "America/Adak",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Anchorage",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Boise",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Chicago",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Denver",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Detroit",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Indianapolis",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Knox",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Marengo",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Petersburg",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Tell_City",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Vevay",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Vincennes",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Indiana/Winamac",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Juneau",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Kentucky/Louisville",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Kentucky/Monticello",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Los_Angeles",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Menominee",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Metlakatla",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/New_York",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Nome",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/North_Dakota/Beulah",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/North_Dakota/Center",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/North_Dakota/New_Salem",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Phoenix",
+				Common:      true,
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Sitka",
+			},
+			{
+				CountryCode: "US",
+				Name:        "America/Yakutat",
+			},
+			{
+				CountryCode: "US",
+				Name:        "Pacific/Honolulu",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "UY",
+		Name:     "Uruguay",
+		Ordinal:  234,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "UY",
+				Name:        "America/Montevideo",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "UZ",
+		Name:     "Uzbekistan",
+		Ordinal:  235,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "UZ",
+				Name:        "Asia/Samarkand",
+			},
+			{
+				CountryCode: "UZ",
+				Name:        "Asia/Tashkent",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "VU",
+		Name:     "Vanuatu",
+		Ordinal:  242,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "VU",
+				Name:        "Pacific/Efate",
+			},
+		},
+	},
+	{
+		Code:     "VE",
+		Name:     "Venezuela (Bolivarian Republic of)",
+		Ordinal:  238,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "VE",
+				Name:        "America/Caracas",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "VN",
+		Name:     "Viet Nam",
+		Ordinal:  241,
+		Synonyms: []string{"Vietnam"},
+		Zones: []Zone{
+			{
+				CountryCode: "VN",
+				Name:        "Asia/Ho_Chi_Minh",
+			},
+		},
+	},
+	{
+		Code:     "VG",
+		Name:     "Virgin Islands (British)",
+		Ordinal:  239,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "VG",
+				Name:        "America/Tortola",
+			},
+		},
+	},
+	{
+		Code:     "VI",
+		Name:     "Virgin Islands (U.S.)",
+		Ordinal:  240,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "VI",
+				Name:        "America/St_Thomas",
+			},
+		},
+	},
+	{
+		Code:     "WF",
+		Name:     "Wallis and Futuna",
+		Ordinal:  243,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "WF",
+				Name:        "Pacific/Wallis",
+			},
+		},
+	},
+	{
+		Code:     "EH",
+		Name:     "Western Sahara",
+		Ordinal:  66,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "EH",
+				Name:        "Africa/El_Aaiun",
+			},
+		},
+	},
+	{
+		Code:     "YE",
+		Name:     "Yemen",
+		Ordinal:  245,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "YE",
+				Name:        "Asia/Aden",
+			},
+		},
+	},
+	{
+		Code:     "ZM",
+		Name:     "Zambia",
+		Ordinal:  248,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "ZM",
+				Name:        "Africa/Lusaka",
+			},
+		},
+	},
+	{
+		Code:     "ZW",
+		Name:     "Zimbabwe",
+		Ordinal:  249,
+		Synonyms: []string{"Rhodesia"},
+		Zones: []Zone{
+			{
+				CountryCode: "ZW",
+				Name:        "Africa/Harare",
+				Common:      true,
+			},
+		},
+	},
+	{
+		Code:     "AX",
+		Name:     "Åland Islands",
+		Ordinal:  15,
+		Synonyms: []string{},
+		Zones: []Zone{
+			{
+				CountryCode: "AX",
+				Name:        "Europe/Mariehamn",
+			},
+		},
+	},
 }
 
-// GetCountry returns a single Country that matches the country
-// code passed and whether it was found
-func GetCountry(code string) (c Country, found bool) {
-	c, found = mapped[code]
-	return
+// loadData returns the countries compiled into the binary.
+func loadData() ([]Country, map[string]Country) {
+	return literalCountries, nil
 }
@@ -0,0 +1,44 @@
+//go:build !tz_literal
+
+package tz
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// GENERATED FILE DO NOT MODIFY DIRECTLY
+
+const (
+	dataSource      = "https://timezonedb.com"
+	dataLicense     = "CC BY 3.0 https://creativecommons.org/licenses/by/3.0/"
+	dataAttribution = "Timezone data provided by TimeZoneDB (https://timezonedb.com), licensed under CC BY 3.0."
+
+	provenanceSourceURL  = "https://timezonedb.com/files/timezonedb.csv.zip"
+	provenanceRelease    = ""
+	provenanceDownloaded = ""
+	provenanceChecksum   = ""
+	provenanceGenerator  = "f8e228b6c9a52cd6205afec9dd41ea4cb77baf2f"
+)
+
+//go:embed tz_data.json
+var encodedCountries []byte
+
+// loadData decodes the countries embedded in the binary.
+func loadData() ([]Country, map[string]Country) {
+	var cs []Country
+	if err := json.Unmarshal(encodedCountries, &cs); err != nil {
+		panic("tz: decoding embedded data: " + err.Error())
+	}
+
+	// empty slices are omitted from the embedded data.
+	for i := range cs {
+		if cs[i].Synonyms == nil {
+			cs[i].Synonyms = []string{}
+		}
+		if cs[i].Zones == nil {
+			cs[i].Zones = []Zone{}
+		}
+	}
+	return cs, nil
+}
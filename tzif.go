@@ -0,0 +1,42 @@
+package tz
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-playground/tz/tzif"
+)
+
+// ExportTZif writes the TZif file, of version 2 or later, of the
+// zone name passed to w, for devices consuming raw TZif data.
+// The zone is read from the system or Go distribution tzdata.
+func ExportTZif(zone string, w io.Writer) error {
+	if err := checkTZdata(zone); err != nil {
+		return err
+	}
+
+	d, err := tzif.Load(zone)
+	if err != nil {
+		return err
+	}
+
+	b, err := d.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// checkTZdata returns an error when the zone passed was registered
+// with RegisterZone, and so has no tzdata to read.
+func checkTZdata(zone string) error {
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	if _, ok := customLocations[zone]; ok {
+		return fmt.Errorf("tz: zone %q is registered, it has no tzdata", zone)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package tzif
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// sources contains the directories and zip files zones are loaded
+// from, in order, after the ZONEINFO environment variable; the
+// system tzdata, then the copy embedded in the Go distribution.
+var sources = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+	filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"),
+}
+
+// Load parses the TZif file of the zone name passed, eg.
+// "America/New_York", looking it up as time.LoadLocation does:
+// in the ZONEINFO environment variable, the system tzdata and
+// the tzdata of the Go distribution.
+func Load(name string) (*Data, error) {
+	b, err := Read(name)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Read returns the raw TZif file of the zone name passed,
+// looked up as by Load.
+func Read(name string) ([]byte, error) {
+	if name == "" || strings.HasPrefix(name, "/") || strings.Contains(name, "..") || strings.Contains(name, `\`) {
+		return nil, errors.New("tzif: invalid zone name " + name)
+	}
+
+	srcs := sources
+	if env := os.Getenv("ZONEINFO"); env != "" {
+		srcs = append([]string{env}, srcs...)
+	}
+
+	for _, src := range srcs {
+		var (
+			b   []byte
+			err error
+		)
+		if strings.HasSuffix(src, ".zip") {
+			b, err = readZip(src, name)
+		} else {
+			b, err = os.ReadFile(filepath.Join(src, name))
+		}
+		if err == nil {
+			return b, nil
+		}
+	}
+
+	return nil, errors.New("tzif: unknown zone " + name)
+}
+
+// readZip returns the file name of the zip file filename.
+func readZip(filename, name string) ([]byte, error) {
+	r, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := r.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
@@ -0,0 +1,244 @@
+package tzif
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TZ is a POSIX TZ string, as found in the Footer of TZif files,
+// describing the local time of a zone and the rules of its DST.
+type TZ struct {
+	// StdName and StdOffset are the designation and offset,
+	// in seconds east of UTC, of standard time.
+	StdName   string
+	StdOffset int
+
+	// DSTName and DSTOffset are those of DST,
+	// DSTName being empty for zones without DST.
+	DSTName   string
+	DSTOffset int
+
+	// Start and End are the transitions to and from DST.
+	Start, End Rule
+}
+
+// HasDST returns whether the zone observes DST.
+func (tz TZ) HasDST() bool {
+	return tz.DSTName != ""
+}
+
+// Rule is the date and time of a DST transition, in the
+// local time in effect before it.
+type Rule struct {
+	// Kind is 'J' for the Julian Day 1 to 365, leap days not
+	// counted, 'N' for the zero-based day of the year and 'M' for
+	// the Day of the week of the Week, 5 meaning last, of Month.
+	Kind             byte
+	Day, Week, Month int
+
+	// Time is the local time of the transition in seconds since
+	// midnight, which may be negative or exceed a day.
+	Time int
+}
+
+// errInvalidTZ is returned for malformed POSIX TZ strings.
+var errInvalidTZ = errors.New("tzif: invalid POSIX TZ string")
+
+// ParseTZ parses the POSIX TZ string s, eg. "EST5EDT,M3.2.0,M11.1.0".
+func ParseTZ(s string) (TZ, error) {
+	var (
+		tz  TZ
+		ok  bool
+		off int
+	)
+
+	if tz.StdName, s, ok = tzName(s); !ok {
+		return TZ{}, errInvalidTZ
+	}
+	if off, s, ok = tzOffset(s); !ok {
+		return TZ{}, errInvalidTZ
+	}
+	tz.StdOffset = -off
+
+	if s == "" {
+		return tz, nil
+	}
+
+	if tz.DSTName, s, ok = tzName(s); !ok {
+		return TZ{}, errInvalidTZ
+	}
+
+	tz.DSTOffset = tz.StdOffset + 3600
+	if s != "" && s[0] != ',' {
+		if off, s, ok = tzOffset(s); !ok {
+			return TZ{}, errInvalidTZ
+		}
+		tz.DSTOffset = -off
+	}
+
+	if s == "" || s[0] != ',' {
+		return TZ{}, errInvalidTZ
+	}
+	if tz.Start, s, ok = tzRule(s[1:]); !ok || s == "" || s[0] != ',' {
+		return TZ{}, errInvalidTZ
+	}
+	if tz.End, s, ok = tzRule(s[1:]); !ok || s != "" {
+		return TZ{}, errInvalidTZ
+	}
+
+	return tz, nil
+}
+
+// tzName parses the leading designation of s, either alphabetic
+// or quoted in angle brackets, eg. "<+0530>".
+func tzName(s string) (string, string, bool) {
+	if strings.HasPrefix(s, "<") {
+		end := strings.IndexByte(s, '>')
+		if end < 2 {
+			return "", s, false
+		}
+		return s[1:end], s[end+1:], true
+	}
+
+	i := 0
+	for i < len(s) && (s[i] >= 'A' && s[i] <= 'Z' || s[i] >= 'a' && s[i] <= 'z') {
+		i++
+	}
+	if i < 3 {
+		return "", s, false
+	}
+	return s[:i], s[i:], true
+}
+
+// tzOffset parses the leading [+-]hh[:mm[:ss]] of s in seconds.
+func tzOffset(s string) (int, string, bool) {
+	sign := 1
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+
+	secs, s, ok := tzTime(s)
+	return sign * secs, s, ok
+}
+
+// tzTime parses the leading hh[:mm[:ss]] of s in seconds.
+func tzTime(s string) (int, string, bool) {
+	secs := 0
+
+	for i, unit := range []int{3600, 60, 1} {
+		if i > 0 {
+			if s == "" || s[0] != ':' {
+				break
+			}
+			s = s[1:]
+		}
+
+		n, rest, ok := tzNumber(s)
+		if !ok {
+			return 0, s, false
+		}
+		secs += n * unit
+		s = rest
+	}
+
+	return secs, s, true
+}
+
+// tzNumber parses the leading digits of s.
+func tzNumber(s string) (int, string, bool) {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, s, false
+	}
+	n, err := strconv.Atoi(s[:i])
+	return n, s[i:], err == nil
+}
+
+// tzRule parses the leading rule of s, eg. "M3.2.0/2".
+func tzRule(s string) (Rule, string, bool) {
+	var (
+		r  Rule
+		ok bool
+	)
+
+	switch {
+	case strings.HasPrefix(s, "J"):
+		r.Kind = 'J'
+		if r.Day, s, ok = tzNumber(s[1:]); !ok || r.Day < 1 || r.Day > 365 {
+			return Rule{}, s, false
+		}
+	case strings.HasPrefix(s, "M"):
+		r.Kind = 'M'
+		s = s[1:]
+		for i, field := range []*int{&r.Month, &r.Week, &r.Day} {
+			if i > 0 {
+				if s == "" || s[0] != '.' {
+					return Rule{}, s, false
+				}
+				s = s[1:]
+			}
+			if *field, s, ok = tzNumber(s); !ok {
+				return Rule{}, s, false
+			}
+		}
+		if r.Month < 1 || r.Month > 12 || r.Week < 1 || r.Week > 5 || r.Day > 6 {
+			return Rule{}, s, false
+		}
+	default:
+		r.Kind = 'N'
+		if r.Day, s, ok = tzNumber(s); !ok || r.Day > 365 {
+			return Rule{}, s, false
+		}
+	}
+
+	r.Time = 2 * 3600
+	if strings.HasPrefix(s, "/") {
+		if r.Time, s, ok = tzOffset(s[1:]); !ok {
+			return Rule{}, s, false
+		}
+	}
+
+	return r, s, true
+}
+
+// On returns the local date and time of the transition in year,
+// as a time in UTC.
+func (r Rule) On(year int) time.Time {
+	var day time.Time
+
+	switch r.Kind {
+	case 'J':
+		// February 29th is never counted.
+		day = time.Date(year, time.January, r.Day, 0, 0, 0, 0, time.UTC)
+		if isLeap(year) && r.Day >= 60 {
+			day = day.AddDate(0, 0, 1)
+		}
+	case 'N':
+		day = time.Date(year, time.January, 1+r.Day, 0, 0, 0, 0, time.UTC)
+	default:
+		first := time.Date(year, time.Month(r.Month), 1, 0, 0, 0, 0, time.UTC)
+		d := 1 + (r.Day-int(first.Weekday())+7)%7 + (r.Week-1)*7
+		for d > daysIn(year, time.Month(r.Month)) {
+			d -= 7
+		}
+		day = time.Date(year, time.Month(r.Month), d, 0, 0, 0, 0, time.UTC)
+	}
+
+	return day.Add(time.Duration(r.Time) * time.Second)
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysIn(year int, m time.Month) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
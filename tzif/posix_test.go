@@ -0,0 +1,126 @@
+package tzif
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTZ(t *testing.T) {
+	tests := []struct {
+		s   string
+		tz  TZ
+		err bool
+	}{
+		{
+			s:  "UTC0",
+			tz: TZ{StdName: "UTC"},
+		},
+		{
+			s: "EST5EDT,M3.2.0,M11.1.0",
+			tz: TZ{
+				StdName: "EST", StdOffset: -5 * 3600,
+				DSTName: "EDT", DSTOffset: -4 * 3600,
+				Start: Rule{Kind: 'M', Month: 3, Week: 2, Day: 0, Time: 2 * 3600},
+				End:   Rule{Kind: 'M', Month: 11, Week: 1, Day: 0, Time: 2 * 3600},
+			},
+		},
+		{
+			s: "<-02>2<-01>,M3.5.0/-1,M10.5.0/0",
+			tz: TZ{
+				StdName: "-02", StdOffset: -2 * 3600,
+				DSTName: "-01", DSTOffset: -1 * 3600,
+				Start: Rule{Kind: 'M', Month: 3, Week: 5, Day: 0, Time: -3600},
+				End:   Rule{Kind: 'M', Month: 10, Week: 5, Day: 0, Time: 0},
+			},
+		},
+		{
+			s: "<+0330>-3:30<+0430>,J79/24,J263/24",
+			tz: TZ{
+				StdName: "+0330", StdOffset: 3*3600 + 30*60,
+				DSTName: "+0430", DSTOffset: 4*3600 + 30*60,
+				Start: Rule{Kind: 'J', Day: 79, Time: 24 * 3600},
+				End:   Rule{Kind: 'J', Day: 263, Time: 24 * 3600},
+			},
+		},
+		{
+			s: "XXX3YYY,0/0,364/0",
+			tz: TZ{
+				StdName: "XXX", StdOffset: -3 * 3600,
+				DSTName: "YYY", DSTOffset: -2 * 3600,
+				Start: Rule{Kind: 'N', Day: 0, Time: 0},
+				End:   Rule{Kind: 'N', Day: 364, Time: 0},
+			},
+		},
+		{s: "", err: true},
+		{s: "EST", err: true},
+		{s: "EST5EDT,M13.1.0,M11.1.0", err: true},
+		{s: "EST5EDT,J0,J365", err: true},
+		{s: "EST5EDT,366,0", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			tz, err := ParseTZ(tt.s)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("got %+v, want error", tz)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tz != tt.tz {
+				t.Errorf("got %+v, want %+v", tz, tt.tz)
+			}
+		})
+	}
+}
+
+func TestRuleOn(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		year int
+		want time.Time
+	}{
+		{
+			name: "M second Sunday",
+			rule: Rule{Kind: 'M', Month: 3, Week: 2, Day: 0, Time: 2 * 3600},
+			year: 2024,
+			want: time.Date(2024, time.March, 10, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "M last Sunday negative time",
+			rule: Rule{Kind: 'M', Month: 3, Week: 5, Day: 0, Time: -3600},
+			year: 2024,
+			want: time.Date(2024, time.March, 30, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "J leap year skips February 29th",
+			rule: Rule{Kind: 'J', Day: 60},
+			year: 2024,
+			want: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "J24 hours",
+			rule: Rule{Kind: 'J', Day: 79, Time: 24 * 3600},
+			year: 2023,
+			want: time.Date(2023, time.March, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "N leap year counts February 29th",
+			rule: Rule{Kind: 'N', Day: 59},
+			year: 2024,
+			want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.On(tt.year); !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Package tzif parses TZif files, the compiled zone information
+// files of the IANA time zone database, as specified by RFC 8536,
+// into an inspectable structure of transitions and local time types.
+package tzif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Data contains the contents of a TZif file.
+type Data struct {
+	// Version is the version of the file, 1 to 4.
+	Version int
+
+	// Transitions contains the transitions between local
+	// time types, sorted by time.
+	Transitions []Transition
+
+	// Types contains the local time types
+	// referenced by Transitions.
+	Types []LocalTimeType
+
+	// Leaps contains the leap second corrections,
+	// empty for files of the usual "right"-less zones.
+	Leaps []Leap
+
+	// Footer is the POSIX TZ string describing the local time
+	// after the last transition, eg. "EST5EDT,M3.2.0,M11.1.0",
+	// empty for version 1 files.
+	Footer string
+}
+
+// Transition is a change of local time type.
+type Transition struct {
+	// At is the instant of the transition.
+	At time.Time
+
+	// Type is the index of the local time type
+	// in effect from At onward.
+	Type int
+}
+
+// LocalTimeType describes a local time, eg. EDT.
+type LocalTimeType struct {
+	// Offset is the offset from UTC in seconds east of UTC.
+	Offset int
+	IsDST  bool
+
+	// Abbreviation is the designation of the local time, eg. "EDT".
+	Abbreviation string
+
+	// IsStd and IsUT are the indicators of whether transitions
+	// to the type were specified as standard or UT time.
+	IsStd, IsUT bool
+}
+
+// Leap is a leap second correction.
+type Leap struct {
+	// At is the instant the correction applies from.
+	At time.Time
+
+	// Correction is the total correction in seconds from At onward.
+	Correction int
+}
+
+// errInvalid is returned for malformed TZif data.
+var errInvalid = errors.New("tzif: invalid TZif data")
+
+// header contains the counts of a TZif header.
+type header struct {
+	version                                               int
+	isutcnt, isstdcnt, leapcnt, timecnt, typecnt, charcnt int
+}
+
+// Parse parses the TZif file b.
+func Parse(b []byte) (*Data, error) {
+	h, rest, err := parseHeader(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.version == 1 {
+		d, _, err := parseBlock(h, rest, 4)
+		return d, err
+	}
+
+	// version 2+ files repeat the data with 64-bit times
+	// after the version 1 block, followed by the footer.
+	if len(rest) < blockSize(h, 4) {
+		return nil, errInvalid
+	}
+	rest = rest[blockSize(h, 4):]
+
+	h2, rest, err := parseHeader(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	d, rest, err := parseBlock(h2, rest, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) < 2 || rest[0] != '\n' {
+		return nil, errInvalid
+	}
+	end := 1
+	for end < len(rest) && rest[end] != '\n' {
+		end++
+	}
+	if end == len(rest) {
+		return nil, errInvalid
+	}
+	d.Footer = string(rest[1:end])
+
+	return d, nil
+}
+
+// parseHeader parses the header at the start of b,
+// returning the rest of b.
+func parseHeader(b []byte) (header, []byte, error) {
+	if len(b) < 44 || string(b[:4]) != "TZif" {
+		return header{}, nil, errInvalid
+	}
+
+	var h header
+
+	switch b[4] {
+	case 0:
+		h.version = 1
+	case '2', '3', '4':
+		h.version = int(b[4] - '0')
+	default:
+		return header{}, nil, fmt.Errorf("tzif: unsupported version %q", b[4])
+	}
+
+	counts := []*int{&h.isutcnt, &h.isstdcnt, &h.leapcnt, &h.timecnt, &h.typecnt, &h.charcnt}
+	for i, c := range counts {
+		*c = int(binary.BigEndian.Uint32(b[20+i*4:]))
+	}
+
+	if h.typecnt == 0 || h.charcnt == 0 ||
+		(h.isutcnt != 0 && h.isutcnt != h.typecnt) ||
+		(h.isstdcnt != 0 && h.isstdcnt != h.typecnt) {
+		return header{}, nil, errInvalid
+	}
+
+	return h, b[44:], nil
+}
+
+// blockSize returns the size of the data block described by h,
+// with times of timeSize bytes.
+func blockSize(h header, timeSize int) int {
+	return h.timecnt*timeSize + h.timecnt + h.typecnt*6 + h.charcnt +
+		h.leapcnt*(timeSize+4) + h.isstdcnt + h.isutcnt
+}
+
+// parseBlock parses the data block described by h, with times of
+// timeSize bytes, at the start of b, returning the rest of b.
+func parseBlock(h header, b []byte, timeSize int) (*Data, []byte, error) {
+	if len(b) < blockSize(h, timeSize) {
+		return nil, nil, errInvalid
+	}
+
+	readTime := func() time.Time {
+		var secs int64
+		if timeSize == 4 {
+			secs = int64(int32(binary.BigEndian.Uint32(b)))
+		} else {
+			secs = int64(binary.BigEndian.Uint64(b))
+		}
+		b = b[timeSize:]
+		return time.Unix(secs, 0).UTC()
+	}
+
+	d := &Data{
+		Version:     h.version,
+		Transitions: make([]Transition, h.timecnt),
+		Types:       make([]LocalTimeType, h.typecnt),
+		Leaps:       make([]Leap, h.leapcnt),
+	}
+
+	for i := range d.Transitions {
+		d.Transitions[i].At = readTime()
+	}
+	for i := range d.Transitions {
+		d.Transitions[i].Type = int(b[i])
+		if d.Transitions[i].Type >= h.typecnt {
+			return nil, nil, errInvalid
+		}
+	}
+	b = b[h.timecnt:]
+
+	desigs := make([]int, h.typecnt)
+	for i := range d.Types {
+		d.Types[i].Offset = int(int32(binary.BigEndian.Uint32(b)))
+		d.Types[i].IsDST = b[4] == 1
+		desigs[i] = int(b[5])
+		b = b[6:]
+	}
+
+	chars := b[:h.charcnt]
+	b = b[h.charcnt:]
+
+	for i, idx := range desigs {
+		if idx >= len(chars) {
+			return nil, nil, errInvalid
+		}
+		end := idx
+		for end < len(chars) && chars[end] != 0 {
+			end++
+		}
+		d.Types[i].Abbreviation = string(chars[idx:end])
+	}
+
+	for i := range d.Leaps {
+		d.Leaps[i].At = readTime()
+		d.Leaps[i].Correction = int(int32(binary.BigEndian.Uint32(b)))
+		b = b[4:]
+	}
+
+	for i := 0; i < h.isstdcnt; i++ {
+		d.Types[i].IsStd = b[i] == 1
+	}
+	b = b[h.isstdcnt:]
+
+	for i := 0; i < h.isutcnt; i++ {
+		d.Types[i].IsUT = b[i] == 1
+	}
+	b = b[h.isutcnt:]
+
+	return d, b, nil
+}
+
+// Lookup returns the local time type in effect at t per the
+// transitions; the Footer, which describes the local time after
+// the last transition, is not evaluated.
+func (d *Data) Lookup(t time.Time) LocalTimeType {
+	i := sort.Search(len(d.Transitions), func(i int) bool {
+		return d.Transitions[i].At.After(t)
+	})
+
+	if i == 0 {
+		// before the first transition the first
+		// local time type is in effect.
+		return d.Types[0]
+	}
+
+	return d.Types[d.Transitions[i-1].Type]
+}
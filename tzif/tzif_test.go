@@ -0,0 +1,40 @@
+package tzif
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// rawHeader returns a TZif header of version with the counts passed,
+// in the order isutcnt, isstdcnt, leapcnt, timecnt, typecnt, charcnt.
+func rawHeader(version byte, counts ...uint32) []byte {
+	b := make([]byte, 44)
+	copy(b, "TZif")
+	b[4] = version
+	for i, c := range counts {
+		binary.BigEndian.PutUint32(b[20+i*4:], c)
+	}
+	return b
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{name: "empty", b: nil},
+		{name: "magic", b: []byte("TZiX2\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00")},
+		{name: "truncated", b: []byte("TZif2")},
+		{name: "truncated v1 block", b: rawHeader(0, 0, 0, 0, 200, 1, 4)},
+		{name: "truncated v2 v1 block", b: rawHeader('2', 0, 0, 0, 200, 1, 4)},
+		{name: "missing v2 header", b: append(rawHeader('2', 0, 0, 0, 0, 1, 4), 0, 0, 0, 0, 0, 0, 'U', 'T', 'C', 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.b); err == nil {
+				t.Error("got nil, want error")
+			}
+		})
+	}
+}
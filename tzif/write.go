@@ -0,0 +1,148 @@
+package tzif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+)
+
+// MarshalBinary encodes the data as a TZif file of version 2,
+// or of Version when later, including a version 1 data block
+// of the transitions representable with 32-bit times.
+func (d *Data) MarshalBinary() ([]byte, error) {
+	if len(d.Types) == 0 || len(d.Types) > 255 {
+		return nil, errors.New("tzif: data must have between 1 and 255 local time types")
+	}
+
+	version := d.Version
+	if version < 2 {
+		version = 2
+	}
+
+	var buff bytes.Buffer
+
+	if err := d.writeBlock(&buff, version, 4); err != nil {
+		return nil, err
+	}
+	if err := d.writeBlock(&buff, version, 8); err != nil {
+		return nil, err
+	}
+
+	buff.WriteByte('\n')
+	buff.WriteString(d.Footer)
+	buff.WriteByte('\n')
+
+	return buff.Bytes(), nil
+}
+
+// writeBlock writes the header and data block, with times
+// of timeSize bytes, to buff.
+func (d *Data) writeBlock(buff *bytes.Buffer, version, timeSize int) error {
+	fits := func(secs int64) bool {
+		return timeSize == 8 || (secs >= math.MinInt32 && secs <= math.MaxInt32)
+	}
+
+	var transitions []Transition
+	for _, t := range d.Transitions {
+		if fits(t.At.Unix()) {
+			transitions = append(transitions, t)
+		}
+	}
+
+	var leaps []Leap
+	for _, l := range d.Leaps {
+		if fits(l.At.Unix()) {
+			leaps = append(leaps, l)
+		}
+	}
+
+	// designations are NUL terminated and shared between types.
+	var chars strings.Builder
+	desigs := make([]int, len(d.Types))
+	offsets := make(map[string]int)
+
+	for i, t := range d.Types {
+		idx, ok := offsets[t.Abbreviation]
+		if !ok {
+			idx = chars.Len()
+			offsets[t.Abbreviation] = idx
+			chars.WriteString(t.Abbreviation)
+			chars.WriteByte(0)
+		}
+		if idx > 255 {
+			return errors.New("tzif: designations exceed 256 bytes")
+		}
+		desigs[i] = idx
+	}
+
+	var isstdcnt, isutcnt int
+	for _, t := range d.Types {
+		if t.IsStd {
+			isstdcnt = len(d.Types)
+		}
+		if t.IsUT {
+			isutcnt = len(d.Types)
+		}
+	}
+
+	buff.WriteString("TZif")
+	buff.WriteByte(byte('0' + version))
+	buff.Write(make([]byte, 15))
+
+	for _, n := range []int{isutcnt, isstdcnt, len(leaps), len(transitions), len(d.Types), chars.Len()} {
+		buff.Write(binary.BigEndian.AppendUint32(nil, uint32(n)))
+	}
+
+	writeTime := func(secs int64) {
+		if timeSize == 4 {
+			buff.Write(binary.BigEndian.AppendUint32(nil, uint32(int32(secs))))
+		} else {
+			buff.Write(binary.BigEndian.AppendUint64(nil, uint64(secs)))
+		}
+	}
+
+	for _, t := range transitions {
+		writeTime(t.At.Unix())
+	}
+	for _, t := range transitions {
+		if t.Type < 0 || t.Type >= len(d.Types) {
+			return errors.New("tzif: transition references an unknown local time type")
+		}
+		buff.WriteByte(byte(t.Type))
+	}
+
+	for i, t := range d.Types {
+		buff.Write(binary.BigEndian.AppendUint32(nil, uint32(int32(t.Offset))))
+		buff.WriteByte(boolByte(t.IsDST))
+		buff.WriteByte(byte(desigs[i]))
+	}
+
+	buff.WriteString(chars.String())
+
+	for _, l := range leaps {
+		writeTime(l.At.Unix())
+		buff.Write(binary.BigEndian.AppendUint32(nil, uint32(int32(l.Correction))))
+	}
+
+	if isstdcnt > 0 {
+		for _, t := range d.Types {
+			buff.WriteByte(boolByte(t.IsStd))
+		}
+	}
+	if isutcnt > 0 {
+		for _, t := range d.Types {
+			buff.WriteByte(boolByte(t.IsUT))
+		}
+	}
+
+	return nil
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
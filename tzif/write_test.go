@@ -0,0 +1,41 @@
+package tzif
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	tests := []string{
+		"UTC",
+		"America/New_York",
+		"America/Nuuk",
+		"Asia/Tehran",
+		"Australia/Lord_Howe",
+		"Europe/Dublin",
+		"Pacific/Kiritimati",
+	}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := Load(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, d) {
+				t.Errorf("got %+v, want %+v", got, d)
+			}
+		})
+	}
+}
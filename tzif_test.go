@@ -0,0 +1,55 @@
+package tz
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestExportTZif(t *testing.T) {
+	tests := []string{
+		"America/New_York",
+		"America/Nuuk",
+		"Asia/Kolkata",
+		"Australia/Lord_Howe",
+		"Europe/Dublin",
+	}
+
+	instants := []time.Time{
+		time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 31, 0, 30, 0, 0, time.UTC),
+		time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, time.October, 27, 1, 30, 0, 0, time.UTC),
+		time.Date(2060, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2060, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := ExportTZif(name, &b); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := time.LoadLocationFromTZData(name, b.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := time.LoadLocation(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, at := range instants {
+				gotName, gotOffset := at.In(got).Zone()
+				wantName, wantOffset := at.In(want).Zone()
+
+				if gotName != wantName || gotOffset != wantOffset {
+					t.Errorf("%v: got %s %d, want %s %d", at, gotName, gotOffset, wantName, wantOffset)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package tz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// InvalidZone is a zone name that isn't a zone of the dataset
+// or that Go can't load, and why.
+type InvalidZone struct {
+	Name string
+	Err  error
+}
+
+// ValidateZones checks the zone names passed using up to concurrency
+// goroutines, GOMAXPROCS when not positive, returning the names that
+// are valid and those that aren't, each in the order passed, eg. for
+// cleaning stored zone names in bulk. Names are valid when they name
+// a zone of the dataset, resolving aliases as Canonicalize does, that
+// Go can load; "" and "Local", which Go loads as UTC and the local
+// zone, are invalid. Names not validated before ctx is done are
+// invalid with the context's error. Locations are cached as by
+// LoadLocation.
+func ValidateZones(ctx context.Context, names []string, concurrency int) (valid []string, invalid []InvalidZone) {
+	load()
+
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	errs := make([]error, len(names))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = validateZone(names[i])
+			}
+		}()
+	}
+
+	for i := range names {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i, name := range names {
+		if errs[i] != nil {
+			invalid = append(invalid, InvalidZone{Name: name, Err: errs[i]})
+		} else {
+			valid = append(valid, name)
+		}
+	}
+	return valid, invalid
+}
+
+// validateZone returns why the zone name passed is invalid,
+// as described by ValidateZones, or nil when valid.
+func validateZone(name string) error {
+	if err := checkZoneName(strings.TrimSpace(name)); err != nil {
+		return err
+	}
+
+	z, found := resolveZone(strings.TrimSpace(name))
+	if !found {
+		return fmt.Errorf("tz: unknown zone %q", name)
+	}
+
+	return CheckLoadable(z.Name)
+}
+
+// CheckLoadable returns why Go can't load the zone name passed, or nil
+// when it can; "" and "Local", which Go loads as UTC and the local zone,
+// aren't zones. Unlike ValidateZones, the name needn't be a zone of the
+// dataset, eg. for the generator checking the zones of a new one.
+// Locations are cached as by LoadLocation.
+func CheckLoadable(name string) error {
+	if err := checkZoneName(name); err != nil {
+		return err
+	}
+
+	_, err := LoadLocation(name)
+	return err
+}
+
+// checkZoneName returns an error for the names Go loads that aren't zones.
+func checkZoneName(name string) error {
+	switch name {
+	case "":
+		return errors.New("tz: zone name is empty")
+	case "Local":
+		return errors.New(`tz: zone "Local" is the local zone, not a zone of the dataset`)
+	}
+	return nil
+}
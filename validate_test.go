@@ -0,0 +1,38 @@
+package tz
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCheckLoadable(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{name: "America/New_York", ok: true},
+		{name: "US/Eastern", ok: true},
+		{name: "", ok: false},
+		{name: "Local", ok: false},
+		{name: "Mars/Olympus_Mons", ok: false},
+	}
+
+	for _, tt := range tests {
+		if err := CheckLoadable(tt.name); (err == nil) != tt.ok {
+			t.Errorf("%q: got %v, want ok %t", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestValidateZones(t *testing.T) {
+	names := []string{"America/New_York", "", "Local", "Mars/Olympus_Mons", " Europe/London "}
+
+	valid, invalid := ValidateZones(context.Background(), names, 2)
+
+	if len(valid) != 2 || valid[0] != "America/New_York" || valid[1] != " Europe/London " {
+		t.Errorf("got valid %q", valid)
+	}
+	if len(invalid) != 3 {
+		t.Errorf("got %d invalid, want 3", len(invalid))
+	}
+}
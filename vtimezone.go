@@ -0,0 +1,154 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/tz/tzif"
+)
+
+// weekdayCodes contains the iCalendar codes of the weekdays.
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// VTimezone returns an RFC 5545 VTIMEZONE component for the zone
+// name passed, with STANDARD and DAYLIGHT sub-components describing
+// the zone's current rules, for use in calendar invites.
+// The rules are read from the system or Go distribution tzdata.
+func VTimezone(zone string) (string, error) {
+	if err := checkTZdata(zone); err != nil {
+		return "", err
+	}
+
+	d, err := tzif.Load(zone)
+	if err != nil {
+		return "", err
+	}
+
+	if d.Footer == "" {
+		return "", errors.New("tz: no current rules found for zone " + zone)
+	}
+
+	rules, err := tzif.ParseTZ(d.Footer)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	line := func(s string) {
+		b.WriteString(s)
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VTIMEZONE")
+	line("TZID:" + zone)
+
+	if !rules.HasDST() {
+		line("BEGIN:STANDARD")
+		line("TZOFFSETFROM:" + icalOffset(rules.StdOffset))
+		line("TZOFFSETTO:" + icalOffset(rules.StdOffset))
+		line("TZNAME:" + rules.StdName)
+		line("DTSTART:19700101T000000")
+		line("END:STANDARD")
+	} else {
+		observance(line, "DAYLIGHT", rules.DSTName, rules.StdOffset, rules.DSTOffset, rules.Start)
+		observance(line, "STANDARD", rules.StdName, rules.DSTOffset, rules.StdOffset, rules.End)
+	}
+
+	line("END:VTIMEZONE")
+
+	return b.String(), nil
+}
+
+// observance writes the sub-component of the given kind taking
+// effect per rule, as a yearly RRULE when expressible and as
+// RDATEs until 2037 otherwise.
+func observance(line func(string), kind, name string, from, to int, rule tzif.Rule) {
+	line("BEGIN:" + kind)
+	line("TZOFFSETFROM:" + icalOffset(from))
+	line("TZOFFSETTO:" + icalOffset(to))
+	line("TZNAME:" + name)
+	line("DTSTART:" + rule.On(1970).Format("20060102T150405"))
+
+	if rrule, ok := icalRRule(rule); ok {
+		line("RRULE:" + rrule)
+	} else {
+		for year := 1971; year <= 2037; year++ {
+			line("RDATE:" + rule.On(year).Format("20060102T150405"))
+		}
+	}
+
+	line("END:" + kind)
+}
+
+// icalRRule returns the yearly RRULE of rule, which is expressible
+// for month based rules whose time shifts them by whole days only
+// within days every month has.
+func icalRRule(rule tzif.Rule) (string, bool) {
+	if rule.Kind != 'M' {
+		return "", false
+	}
+
+	shift := rule.Time / 86400
+	if rule.Time < 0 && rule.Time%86400 != 0 {
+		shift--
+	}
+
+	rrule := "FREQ=YEARLY;BYMONTH=" + strconv.Itoa(rule.Month) + ";BYDAY="
+
+	if shift == 0 {
+		week := strconv.Itoa(rule.Week)
+		if rule.Week == 5 {
+			week = "-1"
+		}
+		return rrule + week + weekdayCodes[rule.Day], true
+	}
+
+	// the last week of February varies with leap years.
+	if rule.Week == 5 && rule.Month == 2 {
+		return "", false
+	}
+
+	// the days of the week of the month, shifted.
+	first := (rule.Week-1)*7 + 1 + shift
+	last := first + 6
+	if rule.Week == 5 {
+		days := daysIn(time.Month(rule.Month))
+		first, last = days-6+shift, days+shift
+	}
+
+	if first < 1 || last > daysIn(time.Month(rule.Month)) {
+		return "", false
+	}
+
+	days := make([]string, 0, 7)
+	for d := first; d <= last; d++ {
+		days = append(days, strconv.Itoa(d))
+	}
+
+	return rrule + weekdayCodes[((rule.Day+shift)%7+7)%7] + ";BYMONTHDAY=" + strings.Join(days, ","), true
+}
+
+// daysIn returns the number of days of month m in a non leap year.
+func daysIn(m time.Month) int {
+	return time.Date(2001, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// icalOffset formats an offset in seconds east of UTC
+// as an iCalendar UTC offset, eg. "-0500".
+func icalOffset(secs int) string {
+	sign := '+'
+	if secs < 0 {
+		sign = '-'
+		secs = -secs
+	}
+
+	s := fmt.Sprintf("%c%02d%02d", sign, secs/3600, secs/60%60)
+	if secs%60 != 0 {
+		s += fmt.Sprintf("%02d", secs%60)
+	}
+	return s
+}
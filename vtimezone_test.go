@@ -0,0 +1,94 @@
+package tz
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/tz/tzif"
+)
+
+func TestICalRRule(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  tzif.Rule
+		rrule string
+		ok    bool
+	}{
+		{
+			name:  "second Sunday",
+			rule:  tzif.Rule{Kind: 'M', Month: 3, Week: 2, Day: 0, Time: 2 * 3600},
+			rrule: "FREQ=YEARLY;BYMONTH=3;BYDAY=2SU",
+			ok:    true,
+		},
+		{
+			name:  "last Sunday",
+			rule:  tzif.Rule{Kind: 'M', Month: 10, Week: 5, Day: 0, Time: 0},
+			rrule: "FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU",
+			ok:    true,
+		},
+		{
+			name:  "last Sunday negative time",
+			rule:  tzif.Rule{Kind: 'M', Month: 3, Week: 5, Day: 0, Time: -3600},
+			rrule: "FREQ=YEARLY;BYMONTH=3;BYDAY=SA;BYMONTHDAY=24,25,26,27,28,29,30",
+			ok:    true,
+		},
+		{
+			name:  "first Sunday past midnight",
+			rule:  tzif.Rule{Kind: 'M', Month: 4, Week: 1, Day: 0, Time: 25 * 3600},
+			rrule: "FREQ=YEARLY;BYMONTH=4;BYDAY=MO;BYMONTHDAY=2,3,4,5,6,7,8",
+			ok:    true,
+		},
+		{
+			name:  "Saturday shifted to Sunday",
+			rule:  tzif.Rule{Kind: 'M', Month: 9, Week: 1, Day: 6, Time: 24 * 3600},
+			rrule: "FREQ=YEARLY;BYMONTH=9;BYDAY=SU;BYMONTHDAY=2,3,4,5,6,7,8",
+			ok:    true,
+		},
+		{
+			name: "first Sunday before the month",
+			rule: tzif.Rule{Kind: 'M', Month: 3, Week: 1, Day: 0, Time: -3600},
+		},
+		{
+			name: "last Sunday past the month",
+			rule: tzif.Rule{Kind: 'M', Month: 10, Week: 5, Day: 0, Time: 25 * 3600},
+		},
+		{
+			name: "last week of February shifted",
+			rule: tzif.Rule{Kind: 'M', Month: 2, Week: 5, Day: 0, Time: -3600},
+		},
+		{
+			name: "Julian day",
+			rule: tzif.Rule{Kind: 'J', Day: 79, Time: 24 * 3600},
+		},
+		{
+			name: "zero-based day",
+			rule: tzif.Rule{Kind: 'N', Day: 78},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rrule, ok := icalRRule(tt.rule)
+			if ok != tt.ok || rrule != tt.rrule {
+				t.Errorf("got %q, %t, want %q, %t", rrule, ok, tt.rrule, tt.ok)
+			}
+		})
+	}
+}
+
+func TestVTimezone(t *testing.T) {
+	v, err := VTimezone("America/Nuuk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"TZID:America/Nuuk",
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=SA;BYMONTHDAY=24,25,26,27,28,29,30",
+		"RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU",
+	} {
+		if !strings.Contains(v, line+"\r\n") {
+			t.Errorf("missing %q in\n%s", line, v)
+		}
+	}
+}